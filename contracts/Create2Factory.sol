@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.15;
+
+/// @title Create2Factory
+/// @author Malte Herrmann
+/// @notice This contract deploys contracts with CREATE2, so that their
+/// address only depends on the factory address, the salt and the init code.
+/** @dev The address of a deployment is
+keccak256(0xff ++ factory ++ salt ++ keccak256(initCode))[12:].
+The sender of the deployed constructor is the factory, which keeps nothing
+for the caller, since anyone can send the same salt and init code. Tokens
+have to be minted to a holder, which is part of the init code.
+*/
+contract Create2Factory {
+    /// @notice Deployed is emitted for every contract deployed by the factory.
+    event Deployed(address indexed addr, bytes32 indexed salt, address indexed deployer);
+
+    /// @notice deploy deploys the given init code with the given salt and
+    /// returns the address of the deployed contract.
+    function deploy(bytes32 salt, bytes memory initCode) external returns (address addr) {
+        require(initCode.length > 0, "Create2Factory: empty init code");
+        assembly {
+            addr := create2(0, add(initCode, 0x20), mload(initCode), salt)
+        }
+        require(addr != address(0), "Create2Factory: deployment failed");
+
+        emit Deployed(addr, salt, msg.sender);
+    }
+
+    /// @notice computeAddress returns the address, which a deployment of the
+    /// init code with the given hash and salt has.
+    function computeAddress(bytes32 salt, bytes32 initCodeHash) external view returns (address) {
+        bytes32 hash = keccak256(abi.encodePacked(bytes1(0xff), address(this), salt, initCodeHash));
+        return address(uint160(uint256(hash)));
+    }
+}
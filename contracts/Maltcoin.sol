@@ -3,20 +3,36 @@ pragma solidity ^0.8.15;
 
 // import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
 import "node_modules/@openzeppelin/contracts/token/ERC20/ERC20.sol";
+// import "@openzeppelin/contracts/token/ERC20/extensions/draft-ERC20Permit.sol";
+import "node_modules/@openzeppelin/contracts/token/ERC20/extensions/draft-ERC20Permit.sol";
 
 /// @title Maltcoin
 /// @author Malte Herrmann
-/// @notice This contract defines an ERC20 token called Maltcoin
+/// @notice This contract defines an ERC20 token, which is called Maltcoin
+/// by default. Approvals can be signed off-chain and submitted by anyone
+/// with permit (EIP-2612), so that holders don't need native tokens to pay
+/// for them.
 /** @dev This contract was generated using the OpenZeppelin contract 
 wizard: https://wizard.openzeppelin.com/
 */ 
-contract Maltcoin is ERC20 {
+contract Maltcoin is ERC20, ERC20Permit {
     /** @notice The constructor function is called upon deployment of the
     contract. It initializes the contract with the name and symbol 
-    of the token.
+    of the token and mints the initial supply.
     */ 
-    /// @dev 10.000 tokens are minted and assigned to the transaction sender.
-    constructor() ERC20("Maltcoin", "MALT") {
-        _mint(msg.sender, 10000 * 10 ** decimals());
+    /// @dev The initial supply is given in the smallest unit of the token
+    /// and assigned to the initial holder or, if it is the zero address,
+    /// to the transaction sender. The EIP-712 domain of permits uses the
+    /// token name and the version "1".
+    constructor(
+        string memory name_,
+        string memory symbol_,
+        uint256 initialSupply,
+        address initialHolder
+    ) ERC20(name_, symbol_) ERC20Permit(name_) {
+        if (initialHolder == address(0)) {
+            initialHolder = msg.sender;
+        }
+        _mint(initialHolder, initialSupply);
     }
-}
\ No newline at end of file
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.15;
+
+import "node_modules/@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "node_modules/@openzeppelin/contracts/token/ERC20/extensions/ERC20Burnable.sol";
+import "node_modules/@openzeppelin/contracts/token/ERC20/extensions/ERC20Pausable.sol";
+import "node_modules/@openzeppelin/contracts/access/AccessControl.sol";
+
+/// @title ManagedMaltcoin
+/// @author Malte Herrmann
+/// @notice This contract defines a managed variant of the Maltcoin ERC20
+/// token, whose supply can be minted and burned and whose transfers can be
+/// paused.
+/** @dev Minting requires the minter role and pausing the pauser role. Both
+roles are administered by the admin role, i.e. the DEFAULT_ADMIN_ROLE of
+AccessControl, which also administers itself. Every holder can burn its own
+tokens and, with an allowance, the tokens of others.
+*/
+contract ManagedMaltcoin is ERC20, ERC20Burnable, ERC20Pausable, AccessControl {
+    /// @notice The role, which is allowed to mint tokens.
+    bytes32 public constant MINTER_ROLE = keccak256("MINTER_ROLE");
+    /// @notice The role, which is allowed to pause and unpause transfers.
+    bytes32 public constant PAUSER_ROLE = keccak256("PAUSER_ROLE");
+
+    /** @notice The constructor function is called upon deployment of the
+    contract. It initializes the contract with the name and symbol of the
+    token, mints the initial supply and grants all roles to the admin.
+    */
+    /// @dev The initial supply is given in the smallest unit of the token.
+    /// If the initial holder or the admin is the zero address, the
+    /// transaction sender is used instead.
+    constructor(
+        string memory name_,
+        string memory symbol_,
+        uint256 initialSupply,
+        address initialHolder,
+        address admin
+    ) ERC20(name_, symbol_) {
+        if (initialHolder == address(0)) {
+            initialHolder = msg.sender;
+        }
+        if (admin == address(0)) {
+            admin = msg.sender;
+        }
+        _grantRole(DEFAULT_ADMIN_ROLE, admin);
+        _grantRole(MINTER_ROLE, admin);
+        _grantRole(PAUSER_ROLE, admin);
+        _mint(initialHolder, initialSupply);
+    }
+
+    /// @notice pause stops all transfers, mints and burns.
+    function pause() public onlyRole(PAUSER_ROLE) {
+        _pause();
+    }
+
+    /// @notice unpause resumes transfers, mints and burns.
+    function unpause() public onlyRole(PAUSER_ROLE) {
+        _unpause();
+    }
+
+    /// @notice mint creates the given amount of tokens for the recipient.
+    function mint(address to, uint256 amount) public onlyRole(MINTER_ROLE) {
+        _mint(to, amount);
+    }
+
+    function _beforeTokenTransfer(
+        address from,
+        address to,
+        uint256 amount
+    ) internal override(ERC20, ERC20Pausable) {
+        super._beforeTokenTransfer(from, to, amount);
+    }
+}
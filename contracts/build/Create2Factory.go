@@ -0,0 +1,416 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package maltcoin
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// Create2FactoryMetaData contains all meta data concerning the Create2Factory contract.
+var Create2FactoryMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"salt\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"deployer\",\"type\":\"address\"}],\"name\":\"Deployed\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"salt\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"initCodeHash\",\"type\":\"bytes32\"}],\"name\":\"computeAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"salt\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"initCode\",\"type\":\"bytes\"}],\"name\":\"deploy\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b506106d0806100206000396000f3fe608060405234801561001057600080fd5b50600436106100365760003560e01c8063481286e61461003b578063cdcb760a1461006b575b600080fd5b61005560048036038101906100509190610249565b61009b565b60405161006291906102ca565b60405180910390f35b6100856004803603810190610080919061042b565b6100de565b60405161009291906102ca565b60405180910390f35b60008060ff60f81b3085856040516020016100b9949392919061053d565b6040516020818303038152906040528051906020012090508060001c91505092915050565b600080825111610123576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161011a906105e8565b60405180910390fd5b828251602084016000f59050600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff160361019e576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016101959061067a565b60405180910390fd5b3373ffffffffffffffffffffffffffffffffffffffff16838273ffffffffffffffffffffffffffffffffffffffff167ffa86dcef4390d6a0f7edde563410fc44e4c2d382b4c6699cce5ebc4071abcc9560405160405180910390a492915050565b6000604051905090565b600080fd5b600080fd5b6000819050919050565b61022681610213565b811461023157600080fd5b50565b6000813590506102438161021d565b92915050565b600080604083850312156102605761025f610209565b5b600061026e85828601610234565b925050602061027f85828601610234565b9150509250929050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b60006102b482610289565b9050919050565b6102c4816102a9565b82525050565b60006020820190506102df60008301846102bb565b92915050565b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b610338826102ef565b810181811067ffffffffffffffff8211171561035757610356610300565b5b80604052505050565b600061036a6101ff565b9050610376828261032f565b919050565b600067ffffffffffffffff82111561039657610395610300565b5b61039f826102ef565b9050602081019050919050565b82818337600083830152505050565b60006103ce6103c98461037b565b610360565b9050828152602081018484840111156103ea576103e96102ea565b5b6103f58482856103ac565b509392505050565b600082601f830112610412576104116102e5565b5b81356104228482602086016103bb565b91505092915050565b6000806040838503121561044257610441610209565b5b600061045085828601610234565b925050602083013567ffffffffffffffff8111156104715761047061020e565b5b61047d858286016103fd565b9150509250929050565b60007fff0000000000000000000000000000000000000000000000000000000000000082169050919050565b6000819050919050565b6104ce6104c982610487565b6104b3565b82525050565b60008160601b9050919050565b60006104ec826104d4565b9050919050565b60006104fe826104e1565b9050919050565b610516610511826102a9565b6104f3565b82525050565b6000819050919050565b61053761053282610213565b61051c565b82525050565b600061054982876104bd565b6001820191506105598286610505565b6014820191506105698285610526565b6020820191506105798284610526565b60208201915081905095945050505050565b600082825260208201905092915050565b7f43726561746532466163746f72793a20656d70747920696e697420636f646500600082015250565b60006105d2601f8361058b565b91506105dd8261059c565b602082019050919050565b60006020820190508181036000830152610601816105c5565b9050919050565b7f43726561746532466163746f72793a206465706c6f796d656e74206661696c6560008201527f6400000000000000000000000000000000000000000000000000000000000000602082015250565b600061066460218361058b565b915061066f82610608565b604082019050919050565b6000602082019050818103600083015261069381610657565b905091905056fea264697066735822122051c8033843f779038c9e25ab287a67c7efde2ab4130ff976be0adacb4a84c90c64736f6c63430008150033",
+}
+
+// Create2FactoryABI is the input ABI used to generate the binding from.
+// Deprecated: Use Create2FactoryMetaData.ABI instead.
+var Create2FactoryABI = Create2FactoryMetaData.ABI
+
+// Create2FactoryBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use Create2FactoryMetaData.Bin instead.
+var Create2FactoryBin = Create2FactoryMetaData.Bin
+
+// DeployCreate2Factory deploys a new Ethereum contract, binding an instance of Create2Factory to it.
+func DeployCreate2Factory(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Create2Factory, error) {
+	parsed, err := Create2FactoryMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(Create2FactoryBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Create2Factory{Create2FactoryCaller: Create2FactoryCaller{contract: contract}, Create2FactoryTransactor: Create2FactoryTransactor{contract: contract}, Create2FactoryFilterer: Create2FactoryFilterer{contract: contract}}, nil
+}
+
+// Create2Factory is an auto generated Go binding around an Ethereum contract.
+type Create2Factory struct {
+	Create2FactoryCaller     // Read-only binding to the contract
+	Create2FactoryTransactor // Write-only binding to the contract
+	Create2FactoryFilterer   // Log filterer for contract events
+}
+
+// Create2FactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Create2FactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Create2FactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Create2FactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Create2FactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Create2FactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Create2FactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Create2FactorySession struct {
+	Contract     *Create2Factory   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Create2FactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Create2FactoryCallerSession struct {
+	Contract *Create2FactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// Create2FactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Create2FactoryTransactorSession struct {
+	Contract     *Create2FactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// Create2FactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Create2FactoryRaw struct {
+	Contract *Create2Factory // Generic contract binding to access the raw methods on
+}
+
+// Create2FactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Create2FactoryCallerRaw struct {
+	Contract *Create2FactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Create2FactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Create2FactoryTransactorRaw struct {
+	Contract *Create2FactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCreate2Factory creates a new instance of Create2Factory, bound to a specific deployed contract.
+func NewCreate2Factory(address common.Address, backend bind.ContractBackend) (*Create2Factory, error) {
+	contract, err := bindCreate2Factory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Create2Factory{Create2FactoryCaller: Create2FactoryCaller{contract: contract}, Create2FactoryTransactor: Create2FactoryTransactor{contract: contract}, Create2FactoryFilterer: Create2FactoryFilterer{contract: contract}}, nil
+}
+
+// NewCreate2FactoryCaller creates a new read-only instance of Create2Factory, bound to a specific deployed contract.
+func NewCreate2FactoryCaller(address common.Address, caller bind.ContractCaller) (*Create2FactoryCaller, error) {
+	contract, err := bindCreate2Factory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Create2FactoryCaller{contract: contract}, nil
+}
+
+// NewCreate2FactoryTransactor creates a new write-only instance of Create2Factory, bound to a specific deployed contract.
+func NewCreate2FactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*Create2FactoryTransactor, error) {
+	contract, err := bindCreate2Factory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Create2FactoryTransactor{contract: contract}, nil
+}
+
+// NewCreate2FactoryFilterer creates a new log filterer instance of Create2Factory, bound to a specific deployed contract.
+func NewCreate2FactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*Create2FactoryFilterer, error) {
+	contract, err := bindCreate2Factory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Create2FactoryFilterer{contract: contract}, nil
+}
+
+// bindCreate2Factory binds a generic wrapper to an already deployed contract.
+func bindCreate2Factory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(Create2FactoryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Create2Factory *Create2FactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Create2Factory.Contract.Create2FactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Create2Factory *Create2FactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Create2Factory.Contract.Create2FactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Create2Factory *Create2FactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Create2Factory.Contract.Create2FactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Create2Factory *Create2FactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Create2Factory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Create2Factory *Create2FactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Create2Factory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Create2Factory *Create2FactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Create2Factory.Contract.contract.Transact(opts, method, params...)
+}
+
+// ComputeAddress is a free data retrieval call binding the contract method 0x481286e6.
+//
+// Solidity: function computeAddress(bytes32 salt, bytes32 initCodeHash) view returns(address)
+func (_Create2Factory *Create2FactoryCaller) ComputeAddress(opts *bind.CallOpts, salt [32]byte, initCodeHash [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _Create2Factory.contract.Call(opts, &out, "computeAddress", salt, initCodeHash)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ComputeAddress is a free data retrieval call binding the contract method 0x481286e6.
+//
+// Solidity: function computeAddress(bytes32 salt, bytes32 initCodeHash) view returns(address)
+func (_Create2Factory *Create2FactorySession) ComputeAddress(salt [32]byte, initCodeHash [32]byte) (common.Address, error) {
+	return _Create2Factory.Contract.ComputeAddress(&_Create2Factory.CallOpts, salt, initCodeHash)
+}
+
+// ComputeAddress is a free data retrieval call binding the contract method 0x481286e6.
+//
+// Solidity: function computeAddress(bytes32 salt, bytes32 initCodeHash) view returns(address)
+func (_Create2Factory *Create2FactoryCallerSession) ComputeAddress(salt [32]byte, initCodeHash [32]byte) (common.Address, error) {
+	return _Create2Factory.Contract.ComputeAddress(&_Create2Factory.CallOpts, salt, initCodeHash)
+}
+
+// Deploy is a paid mutator transaction binding the contract method 0xcdcb760a.
+//
+// Solidity: function deploy(bytes32 salt, bytes initCode) returns(address addr)
+func (_Create2Factory *Create2FactoryTransactor) Deploy(opts *bind.TransactOpts, salt [32]byte, initCode []byte) (*types.Transaction, error) {
+	return _Create2Factory.contract.Transact(opts, "deploy", salt, initCode)
+}
+
+// Deploy is a paid mutator transaction binding the contract method 0xcdcb760a.
+//
+// Solidity: function deploy(bytes32 salt, bytes initCode) returns(address addr)
+func (_Create2Factory *Create2FactorySession) Deploy(salt [32]byte, initCode []byte) (*types.Transaction, error) {
+	return _Create2Factory.Contract.Deploy(&_Create2Factory.TransactOpts, salt, initCode)
+}
+
+// Deploy is a paid mutator transaction binding the contract method 0xcdcb760a.
+//
+// Solidity: function deploy(bytes32 salt, bytes initCode) returns(address addr)
+func (_Create2Factory *Create2FactoryTransactorSession) Deploy(salt [32]byte, initCode []byte) (*types.Transaction, error) {
+	return _Create2Factory.Contract.Deploy(&_Create2Factory.TransactOpts, salt, initCode)
+}
+
+// Create2FactoryDeployedIterator is returned from FilterDeployed and is used to iterate over the raw logs and unpacked data for Deployed events raised by the Create2Factory contract.
+type Create2FactoryDeployedIterator struct {
+	Event *Create2FactoryDeployed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Create2FactoryDeployedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Create2FactoryDeployed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Create2FactoryDeployed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Create2FactoryDeployedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Create2FactoryDeployedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Create2FactoryDeployed represents a Deployed event raised by the Create2Factory contract.
+type Create2FactoryDeployed struct {
+	Addr     common.Address
+	Salt     [32]byte
+	Deployer common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeployed is a free log retrieval operation binding the contract event 0xfa86dcef4390d6a0f7edde563410fc44e4c2d382b4c6699cce5ebc4071abcc95.
+//
+// Solidity: event Deployed(address indexed addr, bytes32 indexed salt, address indexed deployer)
+func (_Create2Factory *Create2FactoryFilterer) FilterDeployed(opts *bind.FilterOpts, addr []common.Address, salt [][32]byte, deployer []common.Address) (*Create2FactoryDeployedIterator, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+	var saltRule []interface{}
+	for _, saltItem := range salt {
+		saltRule = append(saltRule, saltItem)
+	}
+	var deployerRule []interface{}
+	for _, deployerItem := range deployer {
+		deployerRule = append(deployerRule, deployerItem)
+	}
+
+	logs, sub, err := _Create2Factory.contract.FilterLogs(opts, "Deployed", addrRule, saltRule, deployerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Create2FactoryDeployedIterator{contract: _Create2Factory.contract, event: "Deployed", logs: logs, sub: sub}, nil
+}
+
+// WatchDeployed is a free log subscription operation binding the contract event 0xfa86dcef4390d6a0f7edde563410fc44e4c2d382b4c6699cce5ebc4071abcc95.
+//
+// Solidity: event Deployed(address indexed addr, bytes32 indexed salt, address indexed deployer)
+func (_Create2Factory *Create2FactoryFilterer) WatchDeployed(opts *bind.WatchOpts, sink chan<- *Create2FactoryDeployed, addr []common.Address, salt [][32]byte, deployer []common.Address) (event.Subscription, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+	var saltRule []interface{}
+	for _, saltItem := range salt {
+		saltRule = append(saltRule, saltItem)
+	}
+	var deployerRule []interface{}
+	for _, deployerItem := range deployer {
+		deployerRule = append(deployerRule, deployerItem)
+	}
+
+	logs, sub, err := _Create2Factory.contract.WatchLogs(opts, "Deployed", addrRule, saltRule, deployerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Create2FactoryDeployed)
+				if err := _Create2Factory.contract.UnpackLog(event, "Deployed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeployed is a log parse operation binding the contract event 0xfa86dcef4390d6a0f7edde563410fc44e4c2d382b4c6699cce5ebc4071abcc95.
+//
+// Solidity: event Deployed(address indexed addr, bytes32 indexed salt, address indexed deployer)
+func (_Create2Factory *Create2FactoryFilterer) ParseDeployed(log types.Log) (*Create2FactoryDeployed, error) {
+	event := new(Create2FactoryDeployed)
+	if err := _Create2Factory.contract.UnpackLog(event, "Deployed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
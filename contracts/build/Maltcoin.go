@@ -0,0 +1,884 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package maltcoin
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// MaltcoinMetaData contains all meta data concerning the Maltcoin contract.
+var MaltcoinMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialSupply\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"initialHolder\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"permit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6101406040523480156200001257600080fd5b5060405162002aef38038062002aef833981810160405281019062000038919062000591565b83806040518060400160405280600181526020017f31000000000000000000000000000000000000000000000000000000000000008152508686816003908162000083919062000882565b50806004908162000095919062000882565b50505060008280519060200120905060008280519060200120905060007f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f90508260e081815250508161010081815250504660a0818152505062000101818484620001a060201b60201c565b608081815250503073ffffffffffffffffffffffffffffffffffffffff1660c08173ffffffffffffffffffffffffffffffffffffffff1681525050806101208181525050505050505050600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff160362000184573390505b620001968183620001dc60201b60201c565b5050505062000b0d565b60008383834630604051602001620001bd959493929190620009a6565b6040516020818303038152906040528051906020012090509392505050565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff16036200024e576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620002459062000a64565b60405180910390fd5b62000262600083836200035460201b60201c565b806002600082825462000276919062000ab5565b92505081905550806000808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000206000828254620002cd919062000ab5565b925050819055508173ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405162000334919062000af0565b60405180910390a362000350600083836200035960201b60201c565b5050565b505050565b505050565b6000604051905090565b600080fd5b600080fd5b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b620003c7826200037c565b810181811067ffffffffffffffff82111715620003e957620003e86200038d565b5b80604052505050565b6000620003fe6200035e565b90506200040c8282620003bc565b919050565b600067ffffffffffffffff8211156200042f576200042e6200038d565b5b6200043a826200037c565b9050602081019050919050565b60005b83811015620004675780820151818401526020810190506200044a565b60008484015250505050565b60006200048a620004848462000411565b620003f2565b905082815260208101848484011115620004a957620004a862000377565b5b620004b684828562000447565b509392505050565b600082601f830112620004d657620004d562000372565b5b8151620004e884826020860162000473565b91505092915050565b6000819050919050565b6200050681620004f1565b81146200051257600080fd5b50565b6000815190506200052681620004fb565b92915050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b600062000559826200052c565b9050919050565b6200056b816200054c565b81146200057757600080fd5b50565b6000815190506200058b8162000560565b92915050565b60008060008060808587031215620005ae57620005ad62000368565b5b600085015167ffffffffffffffff811115620005cf57620005ce6200036d565b5b620005dd87828801620004be565b945050602085015167ffffffffffffffff8111156200060157620006006200036d565b5b6200060f87828801620004be565b9350506040620006228782880162000515565b925050606062000635878288016200057a565b91505092959194509250565b600081519050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b600060028204905060018216806200069457607f821691505b602082108103620006aa57620006a96200064c565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b600060088302620007147fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff82620006d5565b620007208683620006d5565b95508019841693508086168417925050509392505050565b6000819050919050565b6000620007636200075d6200075784620004f1565b62000738565b620004f1565b9050919050565b6000819050919050565b6200077f8362000742565b620007976200078e826200076a565b848454620006e2565b825550505050565b600090565b620007ae6200079f565b620007bb81848462000774565b505050565b5b81811015620007e357620007d7600082620007a4565b600181019050620007c1565b5050565b601f8211156200083257620007fc81620006b0565b6200080784620006c5565b8101602085101562000817578190505b6200082f6200082685620006c5565b830182620007c0565b50505b505050565b600082821c905092915050565b6000620008576000198460080262000837565b1980831691505092915050565b600062000872838362000844565b9150826002028217905092915050565b6200088d8262000641565b67ffffffffffffffff811115620008a957620008a86200038d565b5b620008b582546200067b565b620008c2828285620007e7565b600060209050601f831160018114620008fa5760008415620008e5578287015190505b620008f1858262000864565b86555062000961565b601f1984166200090a86620006b0565b60005b8281101562000934578489015182556001820191506020850194506020810190506200090d565b8683101562000954578489015162000950601f89168262000844565b8355505b6001600288020188555050505b505050505050565b6000819050919050565b6200097e8162000969565b82525050565b6200098f81620004f1565b82525050565b620009a0816200054c565b82525050565b600060a082019050620009bd600083018862000973565b620009cc602083018762000973565b620009db604083018662000973565b620009ea606083018562000984565b620009f9608083018462000995565b9695505050505050565b600082825260208201905092915050565b7f45524332303a206d696e7420746f20746865207a65726f206164647265737300600082015250565b600062000a4c601f8362000a03565b915062000a598262000a14565b602082019050919050565b6000602082019050818103600083015262000a7f8162000a3d565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b600062000ac282620004f1565b915062000acf83620004f1565b925082820190508082111562000aea5762000ae962000a86565b5b92915050565b600060208201905062000b07600083018462000984565b92915050565b60805160a05160c05160e0516101005161012051611f9262000b5d6000396000610d7501526000610db701526000610d9601526000610ccb01526000610d2101526000610d4a0152611f926000f3fe608060405234801561001057600080fd5b50600436106100ea5760003560e01c806370a082311161008c578063a457c2d711610066578063a457c2d714610275578063a9059cbb146102a5578063d505accf146102d5578063dd62ed3e146102f1576100ea565b806370a08231146101f75780637ecebe001461022757806395d89b4114610257576100ea565b806323b872dd116100c857806323b872dd1461015b578063313ce5671461018b5780633644e515146101a957806339509351146101c7576100ea565b806306fdde03146100ef578063095ea7b31461010d57806318160ddd1461013d575b600080fd5b6100f7610321565b6040516101049190611287565b60405180910390f35b61012760048036038101906101229190611342565b6103b3565b604051610134919061139d565b60405180910390f35b6101456103d6565b60405161015291906113c7565b60405180910390f35b610175600480360381019061017091906113e2565b6103e0565b604051610182919061139d565b60405180910390f35b61019361040f565b6040516101a09190611451565b60405180910390f35b6101b1610418565b6040516101be9190611485565b60405180910390f35b6101e160048036038101906101dc9190611342565b610427565b6040516101ee919061139d565b60405180910390f35b610211600480360381019061020c91906114a0565b61045e565b60405161021e91906113c7565b60405180910390f35b610241600480360381019061023c91906114a0565b6104a6565b60405161024e91906113c7565b60405180910390f35b61025f6104f6565b60405161026c9190611287565b60405180910390f35b61028f600480360381019061028a9190611342565b610588565b60405161029c919061139d565b60405180910390f35b6102bf60048036038101906102ba9190611342565b6105ff565b6040516102cc919061139d565b60405180910390f35b6102ef60048036038101906102ea9190611525565b610622565b005b61030b600480360381019061030691906115c7565b610764565b60405161031891906113c7565b60405180910390f35b60606003805461033090611636565b80601f016020809104026020016040519081016040528092919081815260200182805461035c90611636565b80156103a95780601f1061037e576101008083540402835291602001916103a9565b820191906000526020600020905b81548152906001019060200180831161038c57829003601f168201915b5050505050905090565b6000806103be6107eb565b90506103cb8185856107f3565b600191505092915050565b6000600254905090565b6000806103eb6107eb565b90506103f88582856109bc565b610403858585610a48565b60019150509392505050565b60006012905090565b6000610422610cc7565b905090565b6000806104326107eb565b90506104538185856104448589610764565b61044e9190611696565b6107f3565b600191505092915050565b60008060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b60006104ef600560008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020610de1565b9050919050565b60606004805461050590611636565b80601f016020809104026020016040519081016040528092919081815260200182805461053190611636565b801561057e5780601f106105535761010080835404028352916020019161057e565b820191906000526020600020905b81548152906001019060200180831161056157829003601f168201915b5050505050905090565b6000806105936107eb565b905060006105a18286610764565b9050838110156105e6576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016105dd9061173c565b60405180910390fd5b6105f382868684036107f3565b60019250505092915050565b60008061060a6107eb565b9050610617818585610a48565b600191505092915050565b83421115610665576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161065c906117a8565b60405180910390fd5b60007f6e71edae12b1b97f4d1f60370fef10105fa2faae0126114a169c64845d6126c98888886106948c610def565b896040516020016106aa969594939291906117d7565b60405160208183030381529060405280519060200120905060006106cd82610e4d565b905060006106dd82878787610e67565b90508973ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff161461074d576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161074490611884565b60405180910390fd5b6107588a8a8a6107f3565b50505050505050505050565b6000600160008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905092915050565b600033905090565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603610862576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161085990611916565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff16036108d1576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016108c8906119a8565b60405180910390fd5b80600160008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508173ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925836040516109af91906113c7565b60405180910390a3505050565b60006109c88484610764565b90507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8114610a425781811015610a34576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a2b90611a14565b60405180910390fd5b610a4184848484036107f3565b5b50505050565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603610ab7576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610aae90611aa6565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603610b26576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610b1d90611b38565b60405180910390fd5b610b31838383610e92565b60008060008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905081811015610bb7576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610bae90611bca565b60405180910390fd5b8181036000808673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002081905550816000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000206000828254610c4a9190611696565b925050819055508273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef84604051610cae91906113c7565b60405180910390a3610cc1848484610e97565b50505050565b60007f000000000000000000000000000000000000000000000000000000000000000073ffffffffffffffffffffffffffffffffffffffff163073ffffffffffffffffffffffffffffffffffffffff16148015610d4357507f000000000000000000000000000000000000000000000000000000000000000046145b15610d70577f00000000000000000000000000000000000000000000000000000000000000009050610dde565b610ddb7f00000000000000000000000000000000000000000000000000000000000000007f00000000000000000000000000000000000000000000000000000000000000007f0000000000000000000000000000000000000000000000000000000000000000610e9c565b90505b90565b600081600001549050919050565b600080600560008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000209050610e3c81610de1565b9150610e4781610ed6565b50919050565b6000610e60610e5a610cc7565b83610eec565b9050919050565b6000806000610e7887878787610f1f565b91509150610e858161102b565b8192505050949350505050565b505050565b505050565b60008383834630604051602001610eb7959493929190611bea565b6040516020818303038152906040528051906020012090509392505050565b6001816000016000828254019250508190555050565b60008282604051602001610f01929190611cb5565b60405160208183030381529060405280519060200120905092915050565b6000807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08360001c1115610f5a576000600391509150611022565b601b8560ff1614158015610f725750601c8560ff1614155b15610f84576000600491509150611022565b600060018787878760405160008152602001604052604051610fa99493929190611cec565b6020604051602081039080840390855afa158015610fcb573d6000803e3d6000fd5b505050602060405103519050600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff160361101957600060019250925050611022565b80600092509250505b94509492505050565b6000600481111561103f5761103e611d31565b5b81600481111561105257611051611d31565b5b03156111f4576001600481111561106c5761106b611d31565b5b81600481111561107f5761107e611d31565b5b036110bf576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016110b690611dac565b60405180910390fd5b600260048111156110d3576110d2611d31565b5b8160048111156110e6576110e5611d31565b5b03611126576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161111d90611e18565b60405180910390fd5b6003600481111561113a57611139611d31565b5b81600481111561114d5761114c611d31565b5b0361118d576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161118490611eaa565b60405180910390fd5b6004808111156111a05761119f611d31565b5b8160048111156111b3576111b2611d31565b5b036111f3576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016111ea90611f3c565b60405180910390fd5b5b50565b600081519050919050565b600082825260208201905092915050565b60005b83811015611231578082015181840152602081019050611216565b60008484015250505050565b6000601f19601f8301169050919050565b6000611259826111f7565b6112638185611202565b9350611273818560208601611213565b61127c8161123d565b840191505092915050565b600060208201905081810360008301526112a1818461124e565b905092915050565b600080fd5b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b60006112d9826112ae565b9050919050565b6112e9816112ce565b81146112f457600080fd5b50565b600081359050611306816112e0565b92915050565b6000819050919050565b61131f8161130c565b811461132a57600080fd5b50565b60008135905061133c81611316565b92915050565b60008060408385031215611359576113586112a9565b5b6000611367858286016112f7565b92505060206113788582860161132d565b9150509250929050565b60008115159050919050565b61139781611382565b82525050565b60006020820190506113b2600083018461138e565b92915050565b6113c18161130c565b82525050565b60006020820190506113dc60008301846113b8565b92915050565b6000806000606084860312156113fb576113fa6112a9565b5b6000611409868287016112f7565b935050602061141a868287016112f7565b925050604061142b8682870161132d565b9150509250925092565b600060ff82169050919050565b61144b81611435565b82525050565b60006020820190506114666000830184611442565b92915050565b6000819050919050565b61147f8161146c565b82525050565b600060208201905061149a6000830184611476565b92915050565b6000602082840312156114b6576114b56112a9565b5b60006114c4848285016112f7565b91505092915050565b6114d681611435565b81146114e157600080fd5b50565b6000813590506114f3816114cd565b92915050565b6115028161146c565b811461150d57600080fd5b50565b60008135905061151f816114f9565b92915050565b600080600080600080600060e0888a031215611544576115436112a9565b5b60006115528a828b016112f7565b97505060206115638a828b016112f7565b96505060406115748a828b0161132d565b95505060606115858a828b0161132d565b94505060806115968a828b016114e4565b93505060a06115a78a828b01611510565b92505060c06115b88a828b01611510565b91505092959891949750929550565b600080604083850312156115de576115dd6112a9565b5b60006115ec858286016112f7565b92505060206115fd858286016112f7565b9150509250929050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000600282049050600182168061164e57607f821691505b60208210810361166157611660611607565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b60006116a18261130c565b91506116ac8361130c565b92508282019050808211156116c4576116c3611667565b5b92915050565b7f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f7760008201527f207a65726f000000000000000000000000000000000000000000000000000000602082015250565b6000611726602583611202565b9150611731826116ca565b604082019050919050565b6000602082019050818103600083015261175581611719565b9050919050565b7f45524332305065726d69743a206578706972656420646561646c696e65000000600082015250565b6000611792601d83611202565b915061179d8261175c565b602082019050919050565b600060208201905081810360008301526117c181611785565b9050919050565b6117d1816112ce565b82525050565b600060c0820190506117ec6000830189611476565b6117f960208301886117c8565b61180660408301876117c8565b61181360608301866113b8565b61182060808301856113b8565b61182d60a08301846113b8565b979650505050505050565b7f45524332305065726d69743a20696e76616c6964207369676e61747572650000600082015250565b600061186e601e83611202565b915061187982611838565b602082019050919050565b6000602082019050818103600083015261189d81611861565b9050919050565b7f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460008201527f7265737300000000000000000000000000000000000000000000000000000000602082015250565b6000611900602483611202565b915061190b826118a4565b604082019050919050565b6000602082019050818103600083015261192f816118f3565b9050919050565b7f45524332303a20617070726f766520746f20746865207a65726f20616464726560008201527f7373000000000000000000000000000000000000000000000000000000000000602082015250565b6000611992602283611202565b915061199d82611936565b604082019050919050565b600060208201905081810360008301526119c181611985565b9050919050565b7f45524332303a20696e73756666696369656e7420616c6c6f77616e6365000000600082015250565b60006119fe601d83611202565b9150611a09826119c8565b602082019050919050565b60006020820190508181036000830152611a2d816119f1565b9050919050565b7f45524332303a207472616e736665722066726f6d20746865207a65726f20616460008201527f6472657373000000000000000000000000000000000000000000000000000000602082015250565b6000611a90602583611202565b9150611a9b82611a34565b604082019050919050565b60006020820190508181036000830152611abf81611a83565b9050919050565b7f45524332303a207472616e7366657220746f20746865207a65726f206164647260008201527f6573730000000000000000000000000000000000000000000000000000000000602082015250565b6000611b22602383611202565b9150611b2d82611ac6565b604082019050919050565b60006020820190508181036000830152611b5181611b15565b9050919050565b7f45524332303a207472616e7366657220616d6f756e742065786365656473206260008201527f616c616e63650000000000000000000000000000000000000000000000000000602082015250565b6000611bb4602683611202565b9150611bbf82611b58565b604082019050919050565b60006020820190508181036000830152611be381611ba7565b9050919050565b600060a082019050611bff6000830188611476565b611c0c6020830187611476565b611c196040830186611476565b611c2660608301856113b8565b611c3360808301846117c8565b9695505050505050565b600081905092915050565b7f1901000000000000000000000000000000000000000000000000000000000000600082015250565b6000611c7e600283611c3d565b9150611c8982611c48565b600282019050919050565b6000819050919050565b611caf611caa8261146c565b611c94565b82525050565b6000611cc082611c71565b9150611ccc8285611c9e565b602082019150611cdc8284611c9e565b6020820191508190509392505050565b6000608082019050611d016000830187611476565b611d0e6020830186611442565b611d1b6040830185611476565b611d286060830184611476565b95945050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b7f45434453413a20696e76616c6964207369676e61747572650000000000000000600082015250565b6000611d96601883611202565b9150611da182611d60565b602082019050919050565b60006020820190508181036000830152611dc581611d89565b9050919050565b7f45434453413a20696e76616c6964207369676e6174757265206c656e67746800600082015250565b6000611e02601f83611202565b9150611e0d82611dcc565b602082019050919050565b60006020820190508181036000830152611e3181611df5565b9050919050565b7f45434453413a20696e76616c6964207369676e6174757265202773272076616c60008201527f7565000000000000000000000000000000000000000000000000000000000000602082015250565b6000611e94602283611202565b9150611e9f82611e38565b604082019050919050565b60006020820190508181036000830152611ec381611e87565b9050919050565b7f45434453413a20696e76616c6964207369676e6174757265202776272076616c60008201527f7565000000000000000000000000000000000000000000000000000000000000602082015250565b6000611f26602283611202565b9150611f3182611eca565b604082019050919050565b60006020820190508181036000830152611f5581611f19565b905091905056fea2646970667358221220b6ca41229a0764b28b51048d2bda1bc37aae209a2a048d25892ad86e94161d3664736f6c63430008150033",
+}
+
+// MaltcoinABI is the input ABI used to generate the binding from.
+// Deprecated: Use MaltcoinMetaData.ABI instead.
+var MaltcoinABI = MaltcoinMetaData.ABI
+
+// MaltcoinBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MaltcoinMetaData.Bin instead.
+var MaltcoinBin = MaltcoinMetaData.Bin
+
+// DeployMaltcoin deploys a new Ethereum contract, binding an instance of Maltcoin to it.
+func DeployMaltcoin(auth *bind.TransactOpts, backend bind.ContractBackend, name_ string, symbol_ string, initialSupply *big.Int, initialHolder common.Address) (common.Address, *types.Transaction, *Maltcoin, error) {
+	parsed, err := MaltcoinMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MaltcoinBin), backend, name_, symbol_, initialSupply, initialHolder)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Maltcoin{MaltcoinCaller: MaltcoinCaller{contract: contract}, MaltcoinTransactor: MaltcoinTransactor{contract: contract}, MaltcoinFilterer: MaltcoinFilterer{contract: contract}}, nil
+}
+
+// Maltcoin is an auto generated Go binding around an Ethereum contract.
+type Maltcoin struct {
+	MaltcoinCaller     // Read-only binding to the contract
+	MaltcoinTransactor // Write-only binding to the contract
+	MaltcoinFilterer   // Log filterer for contract events
+}
+
+// MaltcoinCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MaltcoinCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MaltcoinTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MaltcoinTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MaltcoinFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MaltcoinFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MaltcoinSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MaltcoinSession struct {
+	Contract     *Maltcoin         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MaltcoinCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MaltcoinCallerSession struct {
+	Contract *MaltcoinCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// MaltcoinTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MaltcoinTransactorSession struct {
+	Contract     *MaltcoinTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// MaltcoinRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MaltcoinRaw struct {
+	Contract *Maltcoin // Generic contract binding to access the raw methods on
+}
+
+// MaltcoinCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MaltcoinCallerRaw struct {
+	Contract *MaltcoinCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MaltcoinTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MaltcoinTransactorRaw struct {
+	Contract *MaltcoinTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMaltcoin creates a new instance of Maltcoin, bound to a specific deployed contract.
+func NewMaltcoin(address common.Address, backend bind.ContractBackend) (*Maltcoin, error) {
+	contract, err := bindMaltcoin(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Maltcoin{MaltcoinCaller: MaltcoinCaller{contract: contract}, MaltcoinTransactor: MaltcoinTransactor{contract: contract}, MaltcoinFilterer: MaltcoinFilterer{contract: contract}}, nil
+}
+
+// NewMaltcoinCaller creates a new read-only instance of Maltcoin, bound to a specific deployed contract.
+func NewMaltcoinCaller(address common.Address, caller bind.ContractCaller) (*MaltcoinCaller, error) {
+	contract, err := bindMaltcoin(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MaltcoinCaller{contract: contract}, nil
+}
+
+// NewMaltcoinTransactor creates a new write-only instance of Maltcoin, bound to a specific deployed contract.
+func NewMaltcoinTransactor(address common.Address, transactor bind.ContractTransactor) (*MaltcoinTransactor, error) {
+	contract, err := bindMaltcoin(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MaltcoinTransactor{contract: contract}, nil
+}
+
+// NewMaltcoinFilterer creates a new log filterer instance of Maltcoin, bound to a specific deployed contract.
+func NewMaltcoinFilterer(address common.Address, filterer bind.ContractFilterer) (*MaltcoinFilterer, error) {
+	contract, err := bindMaltcoin(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MaltcoinFilterer{contract: contract}, nil
+}
+
+// bindMaltcoin binds a generic wrapper to an already deployed contract.
+func bindMaltcoin(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(MaltcoinABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Maltcoin *MaltcoinRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Maltcoin.Contract.MaltcoinCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Maltcoin *MaltcoinRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Maltcoin.Contract.MaltcoinTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Maltcoin *MaltcoinRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Maltcoin.Contract.MaltcoinTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Maltcoin *MaltcoinCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Maltcoin.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Maltcoin *MaltcoinTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Maltcoin.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Maltcoin *MaltcoinTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Maltcoin.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Maltcoin *MaltcoinCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Maltcoin.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Maltcoin *MaltcoinSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _Maltcoin.Contract.DOMAINSEPARATOR(&_Maltcoin.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Maltcoin *MaltcoinCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _Maltcoin.Contract.DOMAINSEPARATOR(&_Maltcoin.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_Maltcoin *MaltcoinCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Maltcoin.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_Maltcoin *MaltcoinSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _Maltcoin.Contract.Allowance(&_Maltcoin.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_Maltcoin *MaltcoinCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _Maltcoin.Contract.Allowance(&_Maltcoin.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_Maltcoin *MaltcoinCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Maltcoin.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_Maltcoin *MaltcoinSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _Maltcoin.Contract.BalanceOf(&_Maltcoin.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_Maltcoin *MaltcoinCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _Maltcoin.Contract.BalanceOf(&_Maltcoin.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_Maltcoin *MaltcoinCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Maltcoin.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_Maltcoin *MaltcoinSession) Decimals() (uint8, error) {
+	return _Maltcoin.Contract.Decimals(&_Maltcoin.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_Maltcoin *MaltcoinCallerSession) Decimals() (uint8, error) {
+	return _Maltcoin.Contract.Decimals(&_Maltcoin.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Maltcoin *MaltcoinCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Maltcoin.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Maltcoin *MaltcoinSession) Name() (string, error) {
+	return _Maltcoin.Contract.Name(&_Maltcoin.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Maltcoin *MaltcoinCallerSession) Name() (string, error) {
+	return _Maltcoin.Contract.Name(&_Maltcoin.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_Maltcoin *MaltcoinCaller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Maltcoin.contract.Call(opts, &out, "nonces", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_Maltcoin *MaltcoinSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _Maltcoin.Contract.Nonces(&_Maltcoin.CallOpts, owner)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_Maltcoin *MaltcoinCallerSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _Maltcoin.Contract.Nonces(&_Maltcoin.CallOpts, owner)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_Maltcoin *MaltcoinCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Maltcoin.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_Maltcoin *MaltcoinSession) Symbol() (string, error) {
+	return _Maltcoin.Contract.Symbol(&_Maltcoin.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_Maltcoin *MaltcoinCallerSession) Symbol() (string, error) {
+	return _Maltcoin.Contract.Symbol(&_Maltcoin.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Maltcoin *MaltcoinCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Maltcoin.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Maltcoin *MaltcoinSession) TotalSupply() (*big.Int, error) {
+	return _Maltcoin.Contract.TotalSupply(&_Maltcoin.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Maltcoin *MaltcoinCallerSession) TotalSupply() (*big.Int, error) {
+	return _Maltcoin.Contract.TotalSupply(&_Maltcoin.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinTransactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.contract.Transact(opts, "approve", spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.Approve(&_Maltcoin.TransactOpts, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinTransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.Approve(&_Maltcoin.TransactOpts, spender, amount)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_Maltcoin *MaltcoinTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.contract.Transact(opts, "decreaseAllowance", spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_Maltcoin *MaltcoinSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.DecreaseAllowance(&_Maltcoin.TransactOpts, spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_Maltcoin *MaltcoinTransactorSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.DecreaseAllowance(&_Maltcoin.TransactOpts, spender, subtractedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_Maltcoin *MaltcoinTransactor) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.contract.Transact(opts, "increaseAllowance", spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_Maltcoin *MaltcoinSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.IncreaseAllowance(&_Maltcoin.TransactOpts, spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_Maltcoin *MaltcoinTransactorSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.IncreaseAllowance(&_Maltcoin.TransactOpts, spender, addedValue)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_Maltcoin *MaltcoinTransactor) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _Maltcoin.contract.Transact(opts, "permit", owner, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_Maltcoin *MaltcoinSession) Permit(owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _Maltcoin.Contract.Permit(&_Maltcoin.TransactOpts, owner, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_Maltcoin *MaltcoinTransactorSession) Permit(owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _Maltcoin.Contract.Permit(&_Maltcoin.TransactOpts, owner, spender, value, deadline, v, r, s)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinTransactor) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.contract.Transact(opts, "transfer", to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.Transfer(&_Maltcoin.TransactOpts, to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinTransactorSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.Transfer(&_Maltcoin.TransactOpts, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.contract.Transact(opts, "transferFrom", from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinSession) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.TransferFrom(&_Maltcoin.TransactOpts, from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_Maltcoin *MaltcoinTransactorSession) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Maltcoin.Contract.TransferFrom(&_Maltcoin.TransactOpts, from, to, amount)
+}
+
+// MaltcoinApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the Maltcoin contract.
+type MaltcoinApprovalIterator struct {
+	Event *MaltcoinApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaltcoinApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaltcoinApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaltcoinApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaltcoinApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaltcoinApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaltcoinApproval represents a Approval event raised by the Maltcoin contract.
+type MaltcoinApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_Maltcoin *MaltcoinFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*MaltcoinApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _Maltcoin.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MaltcoinApprovalIterator{contract: _Maltcoin.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_Maltcoin *MaltcoinFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *MaltcoinApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _Maltcoin.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaltcoinApproval)
+				if err := _Maltcoin.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_Maltcoin *MaltcoinFilterer) ParseApproval(log types.Log) (*MaltcoinApproval, error) {
+	event := new(MaltcoinApproval)
+	if err := _Maltcoin.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaltcoinTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the Maltcoin contract.
+type MaltcoinTransferIterator struct {
+	Event *MaltcoinTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaltcoinTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaltcoinTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaltcoinTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaltcoinTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaltcoinTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaltcoinTransfer represents a Transfer event raised by the Maltcoin contract.
+type MaltcoinTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Maltcoin *MaltcoinFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*MaltcoinTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Maltcoin.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MaltcoinTransferIterator{contract: _Maltcoin.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Maltcoin *MaltcoinFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *MaltcoinTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Maltcoin.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaltcoinTransfer)
+				if err := _Maltcoin.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Maltcoin *MaltcoinFilterer) ParseTransfer(log types.Log) (*MaltcoinTransfer, error) {
+	event := new(MaltcoinTransfer)
+	if err := _Maltcoin.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1940 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package maltcoin
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ManagedMaltcoinMetaData contains all meta data concerning the ManagedMaltcoin contract.
+var ManagedMaltcoinMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialSupply\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"initialHolder\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"admin\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MINTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"PAUSER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"burnFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60806040523480156200001157600080fd5b506040516200368b3803806200368b833981810160405281019062000037919062000736565b848481600390816200004a919062000a3d565b5080600490816200005c919062000a3d565b5050506000600560006101000a81548160ff021916908315150217905550600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603620000b3573391505b600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1603620000ec573390505b620001016000801b826200018260201b60201c565b620001337f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a6826200018260201b60201c565b620001657f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a826200018260201b60201c565b6200017782846200027460201b60201c565b505050505062000cd7565b620001948282620003ec60201b60201c565b620002705760016006600084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff021916908315150217905550620002156200045760201b60201c565b73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45b5050565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603620002e6576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620002dd9062000b85565b60405180910390fd5b620002fa600083836200045f60201b60201c565b80600260008282546200030e919062000bd6565b92505081905550806000808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020600082825462000365919062000bd6565b925050819055508173ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef83604051620003cc919062000c22565b60405180910390a3620003e8600083836200047760201b60201c565b5050565b60006006600084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16905092915050565b600033905090565b620004728383836200047c60201b60201c565b505050565b505050565b6200048f838383620004e760201b60201c565b6200049f620004ec60201b60201c565b15620004e2576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620004d99062000cb5565b60405180910390fd5b505050565b505050565b6000600560009054906101000a900460ff16905090565b6000604051905090565b600080fd5b600080fd5b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6200056c8262000521565b810181811067ffffffffffffffff821117156200058e576200058d62000532565b5b80604052505050565b6000620005a362000503565b9050620005b1828262000561565b919050565b600067ffffffffffffffff821115620005d457620005d362000532565b5b620005df8262000521565b9050602081019050919050565b60005b838110156200060c578082015181840152602081019050620005ef565b60008484015250505050565b60006200062f6200062984620005b6565b62000597565b9050828152602081018484840111156200064e576200064d6200051c565b5b6200065b848285620005ec565b509392505050565b600082601f8301126200067b576200067a62000517565b5b81516200068d84826020860162000618565b91505092915050565b6000819050919050565b620006ab8162000696565b8114620006b757600080fd5b50565b600081519050620006cb81620006a0565b92915050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000620006fe82620006d1565b9050919050565b6200071081620006f1565b81146200071c57600080fd5b50565b600081519050620007308162000705565b92915050565b600080600080600060a086880312156200075557620007546200050d565b5b600086015167ffffffffffffffff81111562000776576200077562000512565b5b620007848882890162000663565b955050602086015167ffffffffffffffff811115620007a857620007a762000512565b5b620007b68882890162000663565b9450506040620007c988828901620006ba565b9350506060620007dc888289016200071f565b9250506080620007ef888289016200071f565b9150509295509295909350565b600081519050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b600060028204905060018216806200084f57607f821691505b60208210810362000865576200086462000807565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b600060088302620008cf7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8262000890565b620008db868362000890565b95508019841693508086168417925050509392505050565b6000819050919050565b60006200091e62000918620009128462000696565b620008f3565b62000696565b9050919050565b6000819050919050565b6200093a83620008fd565b62000952620009498262000925565b8484546200089d565b825550505050565b600090565b620009696200095a565b620009768184846200092f565b505050565b5b818110156200099e57620009926000826200095f565b6001810190506200097c565b5050565b601f821115620009ed57620009b7816200086b565b620009c28462000880565b81016020851015620009d2578190505b620009ea620009e18562000880565b8301826200097b565b50505b505050565b600082821c905092915050565b600062000a1260001984600802620009f2565b1980831691505092915050565b600062000a2d8383620009ff565b9150826002028217905092915050565b62000a4882620007fc565b67ffffffffffffffff81111562000a645762000a6362000532565b5b62000a70825462000836565b62000a7d828285620009a2565b600060209050601f83116001811462000ab5576000841562000aa0578287015190505b62000aac858262000a1f565b86555062000b1c565b601f19841662000ac5866200086b565b60005b8281101562000aef5784890151825560018201915060208501945060208101905062000ac8565b8683101562000b0f578489015162000b0b601f891682620009ff565b8355505b6001600288020188555050505b505050505050565b600082825260208201905092915050565b7f45524332303a206d696e7420746f20746865207a65726f206164647265737300600082015250565b600062000b6d601f8362000b24565b915062000b7a8262000b35565b602082019050919050565b6000602082019050818103600083015262000ba08162000b5e565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b600062000be38262000696565b915062000bf08362000696565b925082820190508082111562000c0b5762000c0a62000ba7565b5b92915050565b62000c1c8162000696565b82525050565b600060208201905062000c39600083018462000c11565b92915050565b7f45524332305061757361626c653a20746f6b656e207472616e7366657220776860008201527f696c652070617573656400000000000000000000000000000000000000000000602082015250565b600062000c9d602a8362000b24565b915062000caa8262000c3f565b604082019050919050565b6000602082019050818103600083015262000cd08162000c8e565b9050919050565b6129a48062000ce76000396000f3fe608060405234801561001057600080fd5b506004361061018e5760003560e01c80635c975abb116100de578063a217fddf11610097578063d539139311610071578063d539139314610497578063d547741f146104b5578063dd62ed3e146104d1578063e63ab1e9146105015761018e565b8063a217fddf14610419578063a457c2d714610437578063a9059cbb146104675761018e565b80635c975abb1461035757806370a082311461037557806379cc6790146103a55780638456cb59146103c157806391d14854146103cb57806395d89b41146103fb5761018e565b80632f2ff15d1161014b578063395093511161012557806339509351146102e55780633f4ba83a1461031557806340c10f191461031f57806342966c681461033b5761018e565b80632f2ff15d1461028f578063313ce567146102ab57806336568abe146102c95761018e565b806301ffc9a71461019357806306fdde03146101c3578063095ea7b3146101e157806318160ddd1461021157806323b872dd1461022f578063248a9ca31461025f575b600080fd5b6101ad60048036038101906101a89190611aa2565b61051f565b6040516101ba9190611aea565b60405180910390f35b6101cb610599565b6040516101d89190611b95565b60405180910390f35b6101fb60048036038101906101f69190611c4b565b61062b565b6040516102089190611aea565b60405180910390f35b61021961064e565b6040516102269190611c9a565b60405180910390f35b61024960048036038101906102449190611cb5565b610658565b6040516102569190611aea565b60405180910390f35b61027960048036038101906102749190611d3e565b610687565b6040516102869190611d7a565b60405180910390f35b6102a960048036038101906102a49190611d95565b6106a7565b005b6102b36106c8565b6040516102c09190611df1565b60405180910390f35b6102e360048036038101906102de9190611d95565b6106d1565b005b6102ff60048036038101906102fa9190611c4b565b610754565b60405161030c9190611aea565b60405180910390f35b61031d61078b565b005b61033960048036038101906103349190611c4b565b6107c0565b005b61035560048036038101906103509190611e0c565b6107f9565b005b61035f61080d565b60405161036c9190611aea565b60405180910390f35b61038f600480360381019061038a9190611e39565b610824565b60405161039c9190611c9a565b60405180910390f35b6103bf60048036038101906103ba9190611c4b565b61086c565b005b6103c961088c565b005b6103e560048036038101906103e09190611d95565b6108c1565b6040516103f29190611aea565b60405180910390f35b61040361092c565b6040516104109190611b95565b60405180910390f35b6104216109be565b60405161042e9190611d7a565b60405180910390f35b610451600480360381019061044c9190611c4b565b6109c5565b60405161045e9190611aea565b60405180910390f35b610481600480360381019061047c9190611c4b565b610a3c565b60405161048e9190611aea565b60405180910390f35b61049f610a5f565b6040516104ac9190611d7a565b60405180910390f35b6104cf60048036038101906104ca9190611d95565b610a83565b005b6104eb60048036038101906104e69190611e66565b610aa4565b6040516104f89190611c9a565b60405180910390f35b610509610b2b565b6040516105169190611d7a565b60405180910390f35b60007f7965db0b000000000000000000000000000000000000000000000000000000007bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff19161480610592575061059182610b4f565b5b9050919050565b6060600380546105a890611ed5565b80601f01602080910402602001604051908101604052809291908181526020018280546105d490611ed5565b80156106215780601f106105f657610100808354040283529160200191610621565b820191906000526020600020905b81548152906001019060200180831161060457829003601f168201915b5050505050905090565b600080610636610bb9565b9050610643818585610bc1565b600191505092915050565b6000600254905090565b600080610663610bb9565b9050610670858285610d8a565b61067b858585610e16565b60019150509392505050565b600060066000838152602001908152602001600020600101549050919050565b6106b082610687565b6106b981611095565b6106c383836110a9565b505050565b60006012905090565b6106d9610bb9565b73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1614610746576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161073d90611f78565b60405180910390fd5b610750828261118a565b5050565b60008061075f610bb9565b90506107808185856107718589610aa4565b61077b9190611fc7565b610bc1565b600191505092915050565b7f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a6107b581611095565b6107bd61126c565b50565b7f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a66107ea81611095565b6107f483836112cf565b505050565b61080a610804610bb9565b8261142e565b50565b6000600560009054906101000a900460ff16905090565b60008060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b61087e82610878610bb9565b83610d8a565b610888828261142e565b5050565b7f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a6108b681611095565b6108be611604565b50565b60006006600084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16905092915050565b60606004805461093b90611ed5565b80601f016020809104026020016040519081016040528092919081815260200182805461096790611ed5565b80156109b45780601f10610989576101008083540402835291602001916109b4565b820191906000526020600020905b81548152906001019060200180831161099757829003601f168201915b5050505050905090565b6000801b81565b6000806109d0610bb9565b905060006109de8286610aa4565b905083811015610a23576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a1a9061206d565b60405180910390fd5b610a308286868403610bc1565b60019250505092915050565b600080610a47610bb9565b9050610a54818585610e16565b600191505092915050565b7f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a681565b610a8c82610687565b610a9581611095565b610a9f838361118a565b505050565b6000600160008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905092915050565b7f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a81565b60007f01ffc9a7000000000000000000000000000000000000000000000000000000007bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916149050919050565b600033905090565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603610c30576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c27906120ff565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603610c9f576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c9690612191565b60405180910390fd5b80600160008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508173ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92583604051610d7d9190611c9a565b60405180910390a3505050565b6000610d968484610aa4565b90507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8114610e105781811015610e02576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610df9906121fd565b60405180910390fd5b610e0f8484848403610bc1565b5b50505050565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603610e85576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610e7c9061228f565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603610ef4576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610eeb90612321565b60405180910390fd5b610eff838383611667565b60008060008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905081811015610f85576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610f7c906123b3565b60405180910390fd5b8181036000808673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002081905550816000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008282546110189190611fc7565b925050819055508273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161107c9190611c9a565b60405180910390a361108f848484611677565b50505050565b6110a6816110a1610bb9565b61167c565b50565b6110b382826108c1565b6111865760016006600084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff02191690831515021790555061112b610bb9565b73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45b5050565b61119482826108c1565b156112685760006006600084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff02191690831515021790555061120d610bb9565b73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16837ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b60405160405180910390a45b5050565b611274611719565b6000600560006101000a81548160ff0219169083151502179055507f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa6112b8610bb9565b6040516112c591906123e2565b60405180910390a1565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff160361133e576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161133590612449565b60405180910390fd5b61134a60008383611667565b806002600082825461135c9190611fc7565b92505081905550806000808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008282546113b19190611fc7565b925050819055508173ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516114169190611c9a565b60405180910390a361142a60008383611677565b5050565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff160361149d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611494906124db565b60405180910390fd5b6114a982600083611667565b60008060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205490508181101561152f576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016115269061256d565b60405180910390fd5b8181036000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508160026000828254611586919061258d565b92505081905550600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516115eb9190611c9a565b60405180910390a36115ff83600084611677565b505050565b61160c611762565b6001600560006101000a81548160ff0219169083151502179055507f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258611650610bb9565b60405161165d91906123e2565b60405180910390a1565b6116728383836117ac565b505050565b505050565b61168682826108c1565b611715576116ab8173ffffffffffffffffffffffffffffffffffffffff166014611804565b6116b98360001c6020611804565b6040516020016116ca929190612695565b6040516020818303038152906040526040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161170c9190611b95565b60405180910390fd5b5050565b61172161080d565b611760576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016117579061271b565b60405180910390fd5b565b61176a61080d565b156117aa576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016117a190612787565b60405180910390fd5b565b6117b7838383611a40565b6117bf61080d565b156117ff576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016117f690612819565b60405180910390fd5b505050565b6060600060028360026118179190612839565b6118219190611fc7565b67ffffffffffffffff81111561183a5761183961287b565b5b6040519080825280601f01601f19166020018201604052801561186c5781602001600182028036833780820191505090505b5090507f3000000000000000000000000000000000000000000000000000000000000000816000815181106118a4576118a36128aa565b5b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a9053507f780000000000000000000000000000000000000000000000000000000000000081600181518110611908576119076128aa565b5b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350600060018460026119489190612839565b6119529190611fc7565b90505b60018111156119f2577f3031323334353637383961626364656600000000000000000000000000000000600f861660108110611994576119936128aa565b5b1a60f81b8282815181106119ab576119aa6128aa565b5b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350600485901c9450806119eb906128d9565b9050611955565b5060008414611a36576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611a2d9061294e565b60405180910390fd5b8091505092915050565b505050565b600080fd5b60007fffffffff0000000000000000000000000000000000000000000000000000000082169050919050565b611a7f81611a4a565b8114611a8a57600080fd5b50565b600081359050611a9c81611a76565b92915050565b600060208284031215611ab857611ab7611a45565b5b6000611ac684828501611a8d565b91505092915050565b60008115159050919050565b611ae481611acf565b82525050565b6000602082019050611aff6000830184611adb565b92915050565b600081519050919050565b600082825260208201905092915050565b60005b83811015611b3f578082015181840152602081019050611b24565b60008484015250505050565b6000601f19601f8301169050919050565b6000611b6782611b05565b611b718185611b10565b9350611b81818560208601611b21565b611b8a81611b4b565b840191505092915050565b60006020820190508181036000830152611baf8184611b5c565b905092915050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000611be282611bb7565b9050919050565b611bf281611bd7565b8114611bfd57600080fd5b50565b600081359050611c0f81611be9565b92915050565b6000819050919050565b611c2881611c15565b8114611c3357600080fd5b50565b600081359050611c4581611c1f565b92915050565b60008060408385031215611c6257611c61611a45565b5b6000611c7085828601611c00565b9250506020611c8185828601611c36565b9150509250929050565b611c9481611c15565b82525050565b6000602082019050611caf6000830184611c8b565b92915050565b600080600060608486031215611cce57611ccd611a45565b5b6000611cdc86828701611c00565b9350506020611ced86828701611c00565b9250506040611cfe86828701611c36565b9150509250925092565b6000819050919050565b611d1b81611d08565b8114611d2657600080fd5b50565b600081359050611d3881611d12565b92915050565b600060208284031215611d5457611d53611a45565b5b6000611d6284828501611d29565b91505092915050565b611d7481611d08565b82525050565b6000602082019050611d8f6000830184611d6b565b92915050565b60008060408385031215611dac57611dab611a45565b5b6000611dba85828601611d29565b9250506020611dcb85828601611c00565b9150509250929050565b600060ff82169050919050565b611deb81611dd5565b82525050565b6000602082019050611e066000830184611de2565b92915050565b600060208284031215611e2257611e21611a45565b5b6000611e3084828501611c36565b91505092915050565b600060208284031215611e4f57611e4e611a45565b5b6000611e5d84828501611c00565b91505092915050565b60008060408385031215611e7d57611e7c611a45565b5b6000611e8b85828601611c00565b9250506020611e9c85828601611c00565b9150509250929050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b60006002820490506001821680611eed57607f821691505b602082108103611f0057611eff611ea6565b5b50919050565b7f416363657373436f6e74726f6c3a2063616e206f6e6c792072656e6f756e636560008201527f20726f6c657320666f722073656c660000000000000000000000000000000000602082015250565b6000611f62602f83611b10565b9150611f6d82611f06565b604082019050919050565b60006020820190508181036000830152611f9181611f55565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b6000611fd282611c15565b9150611fdd83611c15565b9250828201905080821115611ff557611ff4611f98565b5b92915050565b7f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f7760008201527f207a65726f000000000000000000000000000000000000000000000000000000602082015250565b6000612057602583611b10565b915061206282611ffb565b604082019050919050565b600060208201905081810360008301526120868161204a565b9050919050565b7f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460008201527f7265737300000000000000000000000000000000000000000000000000000000602082015250565b60006120e9602483611b10565b91506120f48261208d565b604082019050919050565b60006020820190508181036000830152612118816120dc565b9050919050565b7f45524332303a20617070726f766520746f20746865207a65726f20616464726560008201527f7373000000000000000000000000000000000000000000000000000000000000602082015250565b600061217b602283611b10565b91506121868261211f565b604082019050919050565b600060208201905081810360008301526121aa8161216e565b9050919050565b7f45524332303a20696e73756666696369656e7420616c6c6f77616e6365000000600082015250565b60006121e7601d83611b10565b91506121f2826121b1565b602082019050919050565b60006020820190508181036000830152612216816121da565b9050919050565b7f45524332303a207472616e736665722066726f6d20746865207a65726f20616460008201527f6472657373000000000000000000000000000000000000000000000000000000602082015250565b6000612279602583611b10565b91506122848261221d565b604082019050919050565b600060208201905081810360008301526122a88161226c565b9050919050565b7f45524332303a207472616e7366657220746f20746865207a65726f206164647260008201527f6573730000000000000000000000000000000000000000000000000000000000602082015250565b600061230b602383611b10565b9150612316826122af565b604082019050919050565b6000602082019050818103600083015261233a816122fe565b9050919050565b7f45524332303a207472616e7366657220616d6f756e742065786365656473206260008201527f616c616e63650000000000000000000000000000000000000000000000000000602082015250565b600061239d602683611b10565b91506123a882612341565b604082019050919050565b600060208201905081810360008301526123cc81612390565b9050919050565b6123dc81611bd7565b82525050565b60006020820190506123f760008301846123d3565b92915050565b7f45524332303a206d696e7420746f20746865207a65726f206164647265737300600082015250565b6000612433601f83611b10565b915061243e826123fd565b602082019050919050565b6000602082019050818103600083015261246281612426565b9050919050565b7f45524332303a206275726e2066726f6d20746865207a65726f2061646472657360008201527f7300000000000000000000000000000000000000000000000000000000000000602082015250565b60006124c5602183611b10565b91506124d082612469565b604082019050919050565b600060208201905081810360008301526124f4816124b8565b9050919050565b7f45524332303a206275726e20616d6f756e7420657863656564732062616c616e60008201527f6365000000000000000000000000000000000000000000000000000000000000602082015250565b6000612557602283611b10565b9150612562826124fb565b604082019050919050565b600060208201905081810360008301526125868161254a565b9050919050565b600061259882611c15565b91506125a383611c15565b92508282039050818111156125bb576125ba611f98565b5b92915050565b600081905092915050565b7f416363657373436f6e74726f6c3a206163636f756e7420000000000000000000600082015250565b60006126026017836125c1565b915061260d826125cc565b601782019050919050565b600061262382611b05565b61262d81856125c1565b935061263d818560208601611b21565b80840191505092915050565b7f206973206d697373696e6720726f6c6520000000000000000000000000000000600082015250565b600061267f6011836125c1565b915061268a82612649565b601182019050919050565b60006126a0826125f5565b91506126ac8285612618565b91506126b782612672565b91506126c38284612618565b91508190509392505050565b7f5061757361626c653a206e6f7420706175736564000000000000000000000000600082015250565b6000612705601483611b10565b9150612710826126cf565b602082019050919050565b60006020820190508181036000830152612734816126f8565b9050919050565b7f5061757361626c653a2070617573656400000000000000000000000000000000600082015250565b6000612771601083611b10565b915061277c8261273b565b602082019050919050565b600060208201905081810360008301526127a081612764565b9050919050565b7f45524332305061757361626c653a20746f6b656e207472616e7366657220776860008201527f696c652070617573656400000000000000000000000000000000000000000000602082015250565b6000612803602a83611b10565b915061280e826127a7565b604082019050919050565b60006020820190508181036000830152612832816127f6565b9050919050565b600061284482611c15565b915061284f83611c15565b925082820261285d81611c15565b9150828204841483151761287457612873611f98565b5b5092915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b60006128e482611c15565b9150600082036128f7576128f6611f98565b5b600182039050919050565b7f537472696e67733a20686578206c656e67746820696e73756666696369656e74600082015250565b6000612938602083611b10565b915061294382612902565b602082019050919050565b600060208201905081810360008301526129678161292b565b905091905056fea2646970667358221220d56964f9efed831a6c6c8ec47b66569ffbc1baa7252f83c2896996ad8429751764736f6c63430008150033",
+}
+
+// ManagedMaltcoinABI is the input ABI used to generate the binding from.
+// Deprecated: Use ManagedMaltcoinMetaData.ABI instead.
+var ManagedMaltcoinABI = ManagedMaltcoinMetaData.ABI
+
+// ManagedMaltcoinBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ManagedMaltcoinMetaData.Bin instead.
+var ManagedMaltcoinBin = ManagedMaltcoinMetaData.Bin
+
+// DeployManagedMaltcoin deploys a new Ethereum contract, binding an instance of ManagedMaltcoin to it.
+func DeployManagedMaltcoin(auth *bind.TransactOpts, backend bind.ContractBackend, name_ string, symbol_ string, initialSupply *big.Int, initialHolder common.Address, admin common.Address) (common.Address, *types.Transaction, *ManagedMaltcoin, error) {
+	parsed, err := ManagedMaltcoinMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ManagedMaltcoinBin), backend, name_, symbol_, initialSupply, initialHolder, admin)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ManagedMaltcoin{ManagedMaltcoinCaller: ManagedMaltcoinCaller{contract: contract}, ManagedMaltcoinTransactor: ManagedMaltcoinTransactor{contract: contract}, ManagedMaltcoinFilterer: ManagedMaltcoinFilterer{contract: contract}}, nil
+}
+
+// ManagedMaltcoin is an auto generated Go binding around an Ethereum contract.
+type ManagedMaltcoin struct {
+	ManagedMaltcoinCaller     // Read-only binding to the contract
+	ManagedMaltcoinTransactor // Write-only binding to the contract
+	ManagedMaltcoinFilterer   // Log filterer for contract events
+}
+
+// ManagedMaltcoinCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ManagedMaltcoinCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ManagedMaltcoinTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ManagedMaltcoinTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ManagedMaltcoinFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ManagedMaltcoinFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ManagedMaltcoinSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ManagedMaltcoinSession struct {
+	Contract     *ManagedMaltcoin  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ManagedMaltcoinCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ManagedMaltcoinCallerSession struct {
+	Contract *ManagedMaltcoinCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// ManagedMaltcoinTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ManagedMaltcoinTransactorSession struct {
+	Contract     *ManagedMaltcoinTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// ManagedMaltcoinRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ManagedMaltcoinRaw struct {
+	Contract *ManagedMaltcoin // Generic contract binding to access the raw methods on
+}
+
+// ManagedMaltcoinCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ManagedMaltcoinCallerRaw struct {
+	Contract *ManagedMaltcoinCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ManagedMaltcoinTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ManagedMaltcoinTransactorRaw struct {
+	Contract *ManagedMaltcoinTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewManagedMaltcoin creates a new instance of ManagedMaltcoin, bound to a specific deployed contract.
+func NewManagedMaltcoin(address common.Address, backend bind.ContractBackend) (*ManagedMaltcoin, error) {
+	contract, err := bindManagedMaltcoin(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoin{ManagedMaltcoinCaller: ManagedMaltcoinCaller{contract: contract}, ManagedMaltcoinTransactor: ManagedMaltcoinTransactor{contract: contract}, ManagedMaltcoinFilterer: ManagedMaltcoinFilterer{contract: contract}}, nil
+}
+
+// NewManagedMaltcoinCaller creates a new read-only instance of ManagedMaltcoin, bound to a specific deployed contract.
+func NewManagedMaltcoinCaller(address common.Address, caller bind.ContractCaller) (*ManagedMaltcoinCaller, error) {
+	contract, err := bindManagedMaltcoin(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinCaller{contract: contract}, nil
+}
+
+// NewManagedMaltcoinTransactor creates a new write-only instance of ManagedMaltcoin, bound to a specific deployed contract.
+func NewManagedMaltcoinTransactor(address common.Address, transactor bind.ContractTransactor) (*ManagedMaltcoinTransactor, error) {
+	contract, err := bindManagedMaltcoin(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinTransactor{contract: contract}, nil
+}
+
+// NewManagedMaltcoinFilterer creates a new log filterer instance of ManagedMaltcoin, bound to a specific deployed contract.
+func NewManagedMaltcoinFilterer(address common.Address, filterer bind.ContractFilterer) (*ManagedMaltcoinFilterer, error) {
+	contract, err := bindManagedMaltcoin(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinFilterer{contract: contract}, nil
+}
+
+// bindManagedMaltcoin binds a generic wrapper to an already deployed contract.
+func bindManagedMaltcoin(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ManagedMaltcoinABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ManagedMaltcoin *ManagedMaltcoinRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ManagedMaltcoin.Contract.ManagedMaltcoinCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ManagedMaltcoin *ManagedMaltcoinRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.ManagedMaltcoinTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ManagedMaltcoin *ManagedMaltcoinRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.ManagedMaltcoinTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ManagedMaltcoin *ManagedMaltcoinCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ManagedMaltcoin.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.contract.Transact(opts, method, params...)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _ManagedMaltcoin.Contract.DEFAULTADMINROLE(&_ManagedMaltcoin.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _ManagedMaltcoin.Contract.DEFAULTADMINROLE(&_ManagedMaltcoin.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) MINTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "MINTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) MINTERROLE() ([32]byte, error) {
+	return _ManagedMaltcoin.Contract.MINTERROLE(&_ManagedMaltcoin.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) MINTERROLE() ([32]byte, error) {
+	return _ManagedMaltcoin.Contract.MINTERROLE(&_ManagedMaltcoin.CallOpts)
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) PAUSERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "PAUSER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) PAUSERROLE() ([32]byte, error) {
+	return _ManagedMaltcoin.Contract.PAUSERROLE(&_ManagedMaltcoin.CallOpts)
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) PAUSERROLE() ([32]byte, error) {
+	return _ManagedMaltcoin.Contract.PAUSERROLE(&_ManagedMaltcoin.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ManagedMaltcoin.Contract.Allowance(&_ManagedMaltcoin.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ManagedMaltcoin.Contract.Allowance(&_ManagedMaltcoin.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ManagedMaltcoin.Contract.BalanceOf(&_ManagedMaltcoin.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ManagedMaltcoin.Contract.BalanceOf(&_ManagedMaltcoin.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Decimals() (uint8, error) {
+	return _ManagedMaltcoin.Contract.Decimals(&_ManagedMaltcoin.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) Decimals() (uint8, error) {
+	return _ManagedMaltcoin.Contract.Decimals(&_ManagedMaltcoin.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _ManagedMaltcoin.Contract.GetRoleAdmin(&_ManagedMaltcoin.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _ManagedMaltcoin.Contract.GetRoleAdmin(&_ManagedMaltcoin.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _ManagedMaltcoin.Contract.HasRole(&_ManagedMaltcoin.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _ManagedMaltcoin.Contract.HasRole(&_ManagedMaltcoin.CallOpts, role, account)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Name() (string, error) {
+	return _ManagedMaltcoin.Contract.Name(&_ManagedMaltcoin.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) Name() (string, error) {
+	return _ManagedMaltcoin.Contract.Name(&_ManagedMaltcoin.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Paused() (bool, error) {
+	return _ManagedMaltcoin.Contract.Paused(&_ManagedMaltcoin.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) Paused() (bool, error) {
+	return _ManagedMaltcoin.Contract.Paused(&_ManagedMaltcoin.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ManagedMaltcoin.Contract.SupportsInterface(&_ManagedMaltcoin.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ManagedMaltcoin.Contract.SupportsInterface(&_ManagedMaltcoin.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Symbol() (string, error) {
+	return _ManagedMaltcoin.Contract.Symbol(&_ManagedMaltcoin.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) Symbol() (string, error) {
+	return _ManagedMaltcoin.Contract.Symbol(&_ManagedMaltcoin.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ManagedMaltcoin.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) TotalSupply() (*big.Int, error) {
+	return _ManagedMaltcoin.Contract.TotalSupply(&_ManagedMaltcoin.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ManagedMaltcoin *ManagedMaltcoinCallerSession) TotalSupply() (*big.Int, error) {
+	return _ManagedMaltcoin.Contract.TotalSupply(&_ManagedMaltcoin.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "approve", spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Approve(&_ManagedMaltcoin.TransactOpts, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Approve(&_ManagedMaltcoin.TransactOpts, spender, amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) Burn(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "burn", amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Burn(amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Burn(&_ManagedMaltcoin.TransactOpts, amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) Burn(amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Burn(&_ManagedMaltcoin.TransactOpts, amount)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x79cc6790.
+//
+// Solidity: function burnFrom(address account, uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) BurnFrom(opts *bind.TransactOpts, account common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "burnFrom", account, amount)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x79cc6790.
+//
+// Solidity: function burnFrom(address account, uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinSession) BurnFrom(account common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.BurnFrom(&_ManagedMaltcoin.TransactOpts, account, amount)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x79cc6790.
+//
+// Solidity: function burnFrom(address account, uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) BurnFrom(account common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.BurnFrom(&_ManagedMaltcoin.TransactOpts, account, amount)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "decreaseAllowance", spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.DecreaseAllowance(&_ManagedMaltcoin.TransactOpts, spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.DecreaseAllowance(&_ManagedMaltcoin.TransactOpts, spender, subtractedValue)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.GrantRole(&_ManagedMaltcoin.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.GrantRole(&_ManagedMaltcoin.TransactOpts, role, account)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "increaseAllowance", spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.IncreaseAllowance(&_ManagedMaltcoin.TransactOpts, spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.IncreaseAllowance(&_ManagedMaltcoin.TransactOpts, spender, addedValue)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "mint", to, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Mint(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Mint(&_ManagedMaltcoin.TransactOpts, to, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) Mint(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Mint(&_ManagedMaltcoin.TransactOpts, to, amount)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Pause() (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Pause(&_ManagedMaltcoin.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) Pause() (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Pause(&_ManagedMaltcoin.TransactOpts)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.RenounceRole(&_ManagedMaltcoin.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.RenounceRole(&_ManagedMaltcoin.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.RevokeRole(&_ManagedMaltcoin.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.RevokeRole(&_ManagedMaltcoin.TransactOpts, role, account)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "transfer", to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Transfer(&_ManagedMaltcoin.TransactOpts, to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Transfer(&_ManagedMaltcoin.TransactOpts, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "transferFrom", from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinSession) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.TransferFrom(&_ManagedMaltcoin.TransactOpts, from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.TransferFrom(&_ManagedMaltcoin.TransactOpts, from, to, amount)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ManagedMaltcoin.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_ManagedMaltcoin *ManagedMaltcoinSession) Unpause() (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Unpause(&_ManagedMaltcoin.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_ManagedMaltcoin *ManagedMaltcoinTransactorSession) Unpause() (*types.Transaction, error) {
+	return _ManagedMaltcoin.Contract.Unpause(&_ManagedMaltcoin.TransactOpts)
+}
+
+// ManagedMaltcoinApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinApprovalIterator struct {
+	Event *ManagedMaltcoinApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagedMaltcoinApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagedMaltcoinApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagedMaltcoinApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagedMaltcoinApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagedMaltcoinApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagedMaltcoinApproval represents a Approval event raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ManagedMaltcoinApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinApprovalIterator{contract: _ManagedMaltcoin.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ManagedMaltcoinApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagedMaltcoinApproval)
+				if err := _ManagedMaltcoin.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) ParseApproval(log types.Log) (*ManagedMaltcoinApproval, error) {
+	event := new(ManagedMaltcoinApproval)
+	if err := _ManagedMaltcoin.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagedMaltcoinPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinPausedIterator struct {
+	Event *ManagedMaltcoinPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagedMaltcoinPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagedMaltcoinPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagedMaltcoinPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagedMaltcoinPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagedMaltcoinPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagedMaltcoinPaused represents a Paused event raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) FilterPaused(opts *bind.FilterOpts) (*ManagedMaltcoinPausedIterator, error) {
+
+	logs, sub, err := _ManagedMaltcoin.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinPausedIterator{contract: _ManagedMaltcoin.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *ManagedMaltcoinPaused) (event.Subscription, error) {
+
+	logs, sub, err := _ManagedMaltcoin.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagedMaltcoinPaused)
+				if err := _ManagedMaltcoin.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) ParsePaused(log types.Log) (*ManagedMaltcoinPaused, error) {
+	event := new(ManagedMaltcoinPaused)
+	if err := _ManagedMaltcoin.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagedMaltcoinRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinRoleAdminChangedIterator struct {
+	Event *ManagedMaltcoinRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagedMaltcoinRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagedMaltcoinRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagedMaltcoinRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagedMaltcoinRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagedMaltcoinRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagedMaltcoinRoleAdminChanged represents a RoleAdminChanged event raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*ManagedMaltcoinRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinRoleAdminChangedIterator{contract: _ManagedMaltcoin.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *ManagedMaltcoinRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagedMaltcoinRoleAdminChanged)
+				if err := _ManagedMaltcoin.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) ParseRoleAdminChanged(log types.Log) (*ManagedMaltcoinRoleAdminChanged, error) {
+	event := new(ManagedMaltcoinRoleAdminChanged)
+	if err := _ManagedMaltcoin.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagedMaltcoinRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinRoleGrantedIterator struct {
+	Event *ManagedMaltcoinRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagedMaltcoinRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagedMaltcoinRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagedMaltcoinRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagedMaltcoinRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagedMaltcoinRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagedMaltcoinRoleGranted represents a RoleGranted event raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ManagedMaltcoinRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinRoleGrantedIterator{contract: _ManagedMaltcoin.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *ManagedMaltcoinRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagedMaltcoinRoleGranted)
+				if err := _ManagedMaltcoin.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) ParseRoleGranted(log types.Log) (*ManagedMaltcoinRoleGranted, error) {
+	event := new(ManagedMaltcoinRoleGranted)
+	if err := _ManagedMaltcoin.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagedMaltcoinRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinRoleRevokedIterator struct {
+	Event *ManagedMaltcoinRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagedMaltcoinRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagedMaltcoinRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagedMaltcoinRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagedMaltcoinRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagedMaltcoinRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagedMaltcoinRoleRevoked represents a RoleRevoked event raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ManagedMaltcoinRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinRoleRevokedIterator{contract: _ManagedMaltcoin.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *ManagedMaltcoinRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagedMaltcoinRoleRevoked)
+				if err := _ManagedMaltcoin.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) ParseRoleRevoked(log types.Log) (*ManagedMaltcoinRoleRevoked, error) {
+	event := new(ManagedMaltcoinRoleRevoked)
+	if err := _ManagedMaltcoin.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagedMaltcoinTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinTransferIterator struct {
+	Event *ManagedMaltcoinTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagedMaltcoinTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagedMaltcoinTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagedMaltcoinTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagedMaltcoinTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagedMaltcoinTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagedMaltcoinTransfer represents a Transfer event raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ManagedMaltcoinTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinTransferIterator{contract: _ManagedMaltcoin.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ManagedMaltcoinTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ManagedMaltcoin.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagedMaltcoinTransfer)
+				if err := _ManagedMaltcoin.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) ParseTransfer(log types.Log) (*ManagedMaltcoinTransfer, error) {
+	event := new(ManagedMaltcoinTransfer)
+	if err := _ManagedMaltcoin.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagedMaltcoinUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinUnpausedIterator struct {
+	Event *ManagedMaltcoinUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagedMaltcoinUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagedMaltcoinUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagedMaltcoinUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagedMaltcoinUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagedMaltcoinUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagedMaltcoinUnpaused represents a Unpaused event raised by the ManagedMaltcoin contract.
+type ManagedMaltcoinUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) FilterUnpaused(opts *bind.FilterOpts) (*ManagedMaltcoinUnpausedIterator, error) {
+
+	logs, sub, err := _ManagedMaltcoin.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedMaltcoinUnpausedIterator{contract: _ManagedMaltcoin.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *ManagedMaltcoinUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _ManagedMaltcoin.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagedMaltcoinUnpaused)
+				if err := _ManagedMaltcoin.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_ManagedMaltcoin *ManagedMaltcoinFilterer) ParseUnpaused(log types.Log) (*ManagedMaltcoinUnpaused, error) {
+	event := new(ManagedMaltcoinUnpaused)
+	if err := _ManagedMaltcoin.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,15 @@
+// Package maltcoin contains the Go bindings of the Maltcoin contract, of its
+// managed variant ManagedMaltcoin and of the Create2Factory contract, which
+// deploys contracts at deterministic addresses.
+//
+// The bindings are generated from the committed solc artifacts, i.e. the
+// .abi and .bin files, which were compiled with solc 0.8.21 (evmVersion
+// london, optimizer disabled). After recompiling the contract, update the bindings
+// with:
+//
+//	$ go generate ./contracts/...
+package maltcoin
+
+//go:generate go run github.com/MalteHerrmann/GoSmartContract/scripts/bindgen/cmd/bindgen -abi Maltcoin.abi -bin Maltcoin.bin -type Maltcoin -pkg maltcoin -out Maltcoin.go
+//go:generate go run github.com/MalteHerrmann/GoSmartContract/scripts/bindgen/cmd/bindgen -abi ManagedMaltcoin.abi -bin ManagedMaltcoin.bin -type ManagedMaltcoin -pkg maltcoin -out ManagedMaltcoin.go
+//go:generate go run github.com/MalteHerrmann/GoSmartContract/scripts/bindgen/cmd/bindgen -abi Create2Factory.abi -bin Create2Factory.bin -type Create2Factory -pkg maltcoin -out Create2Factory.go
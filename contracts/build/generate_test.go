@@ -0,0 +1,21 @@
+// generate_test.go checks, that the committed bindings match the committed
+// contract artifacts.
+package maltcoin
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/bindgen"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBindingsInSync tests, that regenerating the bindings from the .abi and
+// .bin files does not change the committed bindings.
+func TestBindingsInSync(t *testing.T) {
+	for _, name := range []string{"Maltcoin", "ManagedMaltcoin", "Create2Factory"} {
+		t.Run(name, func(t *testing.T) {
+			contract := bindgen.Contract{Type: name, ABIPath: name + ".abi", BinPath: name + ".bin"}
+			require.NoError(t, bindgen.Check(name+".go", "maltcoin", contract))
+		})
+	}
+}
@@ -2,51 +2,32 @@
 # Script to successfully deploy a Solidity 
 # smart contract to a local Evmos node.
 # 
-# After deployment, the transaction receipt is read
-# and a simple token transfer between two accounts
-# is executed.
+# The bootstrap command imports the sender key, deploys the
+# committed contract artifacts, verifies the deployed code and
+# executes a simple token transfer between two accounts.
 # ------------------------
+set -euo pipefail
+
 # User settings
 SENDER_KEYNAME=mykey
 RECIPIENT_KEYNAME=testKey
-AMOUNT=10000000
-
-# File paths
-DEPLOY=scripts/deploy/deploy_contract.go
-RECEIPT=scripts/receipt/receipt.go
-QUERY=scripts/transfer/query_and_transfer.go
-
-# Derive account information from evmosd CLI 
-SENDER_PRIVKEY=$(evmosd keys unsafe-export-eth-key $SENDER_KEYNAME --keyring-backend=test)
-SENDER_BECH32=$(evmosd keys show $SENDER_KEYNAME| grep 'address' | grep -o 'evmos[0-9a-z]*')
-SENDER_HEX=0x$(evmosd keys parse $SENDER_BECH32 | grep 'bytes' | grep -o '[0-9A-Z]*')
-RECIPIENT_BECH32=$(evmosd keys show $RECIPIENT_KEYNAME| grep 'address' | grep -o 'evmos[0-9a-z]*')
-RECIPIENT_HEX=0x$(evmosd keys parse $RECIPIENT_BECH32 | grep 'bytes' | grep -o '[0-9A-Z]*')
-
-# Remove previous build files
-rm -rf contracts/build*
-
-# Compile contract
-solc --abi contracts/Maltcoin.sol -o contracts/build
-solc --bin contracts/Maltcoin.sol -o contracts/build
-
-# Generate go bindings
-abigen --abi=contracts/build/Maltcoin.abi --bin=contracts/build/Maltcoin.bin --pkg=maltcoin --out=contracts/build/Maltcoin.go
-
-# Run deployment function
-go run $DEPLOY $SENDER_PRIVKEY > tmp.txt
-cat tmp.txt
-TXHASH=$(cat tmp.txt | grep "transaction" | grep -o "0x[a-z0-9]*")
-CONTRACT=$(cat tmp.txt | grep 'contract address' | grep -o '0x[0-9a-zA-Z]*')
-rm -f tmp.txt
-
-# Wait for transaction to be included in a block
-echo "Waiting for transaction to be included in a block .. "
-sleep 5
-
-# Run receipt function
-go run $RECEIPT $TXHASH
-
-# Query name of token
-go run $QUERY $CONTRACT $SENDER_PRIVKEY $RECIPIENT_HEX $AMOUNT
-
+AMOUNT=10
+
+# Command line interface
+MALTCOIN="go run ./scripts/maltcoin"
+
+# Keystore for the signing keys. The passphrase is only meant 
+# for the local test node.
+export MALTCOIN_KEYSTORE=keystore
+export MALTCOIN_PASSWORD=maltcoin-localnet
+
+# Import the sender key from evmosd, deploy the committed contract, wait for
+# the confirmation, verify the code and transfer tokens to the recipient.
+# Failed steps are retried and the summary is written to bootstrap.json.
+$MALTCOIN bootstrap \
+  --evmosd-key $SENDER_KEYNAME \
+  --evmosd-recipient $RECIPIENT_KEYNAME \
+  --abi contracts/build/Maltcoin.abi \
+  --bin contracts/build/Maltcoin.bin \
+  --amount $AMOUNT \
+  --summary bootstrap.json
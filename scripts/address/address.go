@@ -0,0 +1,110 @@
+// Package address parses and formats account addresses, which are either
+// given as EIP-55 hex strings or as bech32 strings with a human-readable
+// prefix, e.g. "evmos1…". Both forms encode the same 20 bytes on Evmos, so
+// that addresses can be converted between them.
+package address
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultHRP is the human-readable part of bech32 account addresses
+	// on Evmos.
+	DefaultHRP = "evmos"
+
+	// EnvHRP is the environment variable, which overrides the
+	// human-readable part of bech32 addresses.
+	EnvHRP = "MALTCOIN_BECH32_PREFIX"
+)
+
+var (
+	// ErrInvalidAddress is returned, when a string is neither a valid hex
+	// nor a valid bech32 address.
+	ErrInvalidAddress = errors.New("invalid address")
+
+	// ErrChecksum is returned, when the EIP-55 checksum of a mixed-case
+	// hex address does not match.
+	ErrChecksum = errors.New("invalid EIP-55 checksum")
+
+	// ErrWrongPrefix is returned, when a bech32 address has another
+	// human-readable part than expected.
+	ErrWrongPrefix = errors.New("wrong bech32 prefix")
+)
+
+// Parse converts a hex or bech32 address. Hex addresses must have the 0x
+// prefix and, if they contain upper and lower case letters, a valid EIP-55
+// checksum. Bech32 addresses must have the given human-readable part.
+func Parse(value, hrp string) (common.Address, error) {
+	if has0xPrefix(value) {
+		return ParseHex(value)
+	}
+
+	return ParseBech32(value, hrp)
+}
+
+// ParseHex converts a hex address with 0x prefix. Addresses in only lower
+// or only upper case are accepted without checksum, mixed-case addresses
+// have to match their EIP-55 checksum.
+func ParseHex(value string) (common.Address, error) {
+	if !has0xPrefix(value) {
+		return common.Address{}, fmt.Errorf("%w %q: missing 0x prefix", ErrInvalidAddress, value)
+	}
+	digits := value[2:]
+	if len(digits) != 2*common.AddressLength {
+		return common.Address{}, fmt.Errorf("%w %q: expected %d hex digits", ErrInvalidAddress, value, 2*common.AddressLength)
+	}
+	bz, err := hex.DecodeString(digits)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w %q: not a hex string", ErrInvalidAddress, value)
+	}
+
+	address := common.BytesToAddress(bz)
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && address.Hex()[2:] != digits {
+		return common.Address{}, fmt.Errorf("%w %q, expected %s", ErrChecksum, value, address.Hex())
+	}
+
+	return address, nil
+}
+
+// ParseBech32 converts a bech32 address with the given human-readable part.
+func ParseBech32(value, hrp string) (common.Address, error) {
+	prefix, data, err := decodeBech32(value)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w %q: %v", ErrInvalidAddress, value, err)
+	}
+	if prefix != strings.ToLower(hrp) {
+		return common.Address{}, fmt.Errorf("%w %q: expected prefix %q", ErrWrongPrefix, value, hrp)
+	}
+
+	bz, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w %q: %v", ErrInvalidAddress, value, err)
+	}
+	if len(bz) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("%w %q: expected %d bytes, got %d", ErrInvalidAddress, value, common.AddressLength, len(bz))
+	}
+
+	return common.BytesToAddress(bz), nil
+}
+
+// Bech32 formats the address as bech32 string with the given
+// human-readable part.
+func Bech32(address common.Address, hrp string) (string, error) {
+	data, err := convertBits(address.Bytes(), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(hrp, data)
+}
+
+// has0xPrefix returns true, if the value starts with 0x or 0X.
+func has0xPrefix(value string) bool {
+	return len(value) >= 2 && value[0] == '0' && (value[1] == 'x' || value[1] == 'X')
+}
@@ -0,0 +1,111 @@
+// address_test.go contains the unit tests for parsing and formatting
+// hex and bech32 addresses.
+package address
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecodeBech32 tests the bech32 decoding with the test vectors of
+// BIP-173.
+func TestDecodeBech32(t *testing.T) {
+	testcases := []struct {
+		name   string
+		value  string
+		expErr bool
+	}{
+		{"passes - upper case", "A12UEL5L", false},
+		{"passes - lower case", "a12uel5l", false},
+		{"passes - long prefix", "an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs", false},
+		{"passes - full charset", "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", false},
+		{"passes - separator in prefix", "split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w", false},
+		{"passes - special characters in prefix", "?1ezyfcl", false},
+		{"fails - too long", "an84characterslonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1569pvx", true},
+		{"fails - no separator", "pzry9x0s0muk", true},
+		{"fails - empty prefix", "1pzry9x0s0muk", true},
+		{"fails - invalid character", "x1b4n0q5v", true},
+		{"fails - checksum too short", "li1dgmt3", true},
+		{"fails - mixed case", "A12uEL5L", true},
+		{"fails - wrong checksum", "a12uel5m", true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			hrp, data, err := decodeBech32(tc.value)
+			if tc.expErr {
+				require.Error(t, err, "Invalid bech32 string should raise an error")
+				return
+			}
+
+			require.NoError(t, err, "Error decoding bech32 string")
+			encoded, err := encodeBech32(hrp, data)
+			require.NoError(t, err, "Error encoding bech32 string")
+			require.Equal(t, strings.ToLower(tc.value), encoded, "Encoding should reproduce the string")
+		})
+	}
+
+	// Segwit address of BIP-173, which contains the witness version and a
+	// 20 byte program
+	_, data, err := decodeBech32("BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4")
+	require.NoError(t, err, "Error decoding segwit address")
+	program, err := convertBits(data[1:], 5, 8, false)
+	require.NoError(t, err, "Error converting witness program")
+	require.Equal(t, common.FromHex("751e76e8199196d454941c45d1b3a323f1433bd6"), program, "Wrong witness program")
+}
+
+// TestParse tests parsing hex and bech32 addresses.
+func TestParse(t *testing.T) {
+	expAddress := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	bech32Address, err := Bech32(expAddress, DefaultHRP)
+	require.NoError(t, err, "Error formatting bech32 address")
+	require.True(t, strings.HasPrefix(bech32Address, "evmos1"), "Wrong bech32 prefix")
+	otherPrefix, err := Bech32(expAddress, "cosmos")
+	require.NoError(t, err, "Error formatting bech32 address")
+
+	testcases := []struct {
+		name   string
+		value  string
+		expErr error
+	}{
+		{"passes - EIP-55 checksum", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"passes - lower case hex", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", nil},
+		{"passes - upper case hex", "0X5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", nil},
+		{"passes - bech32", bech32Address, nil},
+		{"passes - upper case bech32", strings.ToUpper(bech32Address), nil},
+		{"fails - wrong checksum", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", ErrChecksum},
+		{"fails - missing digits", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", ErrInvalidAddress},
+		{"fails - no hex digits", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", ErrInvalidAddress},
+		{"fails - hex without prefix", "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrInvalidAddress},
+		{"fails - wrong bech32 prefix", otherPrefix, ErrWrongPrefix},
+		{"fails - bech32 with wrong checksum", bech32Address[:len(bech32Address)-1] + "q", ErrInvalidAddress},
+		{"fails - empty", "", ErrInvalidAddress},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			address, err := Parse(tc.value, DefaultHRP)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr, "Wrong error")
+				return
+			}
+
+			require.NoError(t, err, "Error parsing address")
+			require.Equal(t, expAddress, address, "Wrong address")
+		})
+	}
+
+	// Address from the Evmos documentation
+	evmosAddress, err := ParseBech32("evmos1z3t55m0l9h0eupuz3dp5t5cypyv674jj7mz2jw", DefaultHRP)
+	require.NoError(t, err, "Error parsing Evmos address")
+	require.Equal(t, common.HexToAddress("0x14574a6DFF2Ddf9e07828b4345d3040919AF5652"), evmosAddress, "Wrong address")
+
+	// Bech32 strings, which do not encode 20 bytes, are rejected
+	short, err := encodeBech32(DefaultHRP, []byte{1, 2, 3, 4})
+	require.NoError(t, err, "Error encoding bech32 string")
+	_, err = ParseBech32(short, DefaultHRP)
+	require.ErrorIs(t, err, ErrInvalidAddress, "Short bech32 address should raise an error")
+}
@@ -0,0 +1,170 @@
+// bech32.go contains the encoding and decoding of bech32 strings as
+// specified in BIP-173, which Cosmos SDK chains like Evmos use to
+// represent account addresses.
+package address
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// charset contains the characters of the bech32 alphabet in the order
+// of their 5-bit values.
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// maxBech32Length is the maximum length of a bech32 string.
+const maxBech32Length = 90
+
+// checksumLength is the number of characters of the bech32 checksum.
+const checksumLength = 6
+
+// generator contains the coefficients of the BCH code of the checksum.
+var generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// polymod computes the BCH checksum of the given 5-bit values.
+func polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i, g := range generator {
+			if (top>>i)&1 == 1 {
+				chk ^= g
+			}
+		}
+	}
+
+	return chk
+}
+
+// expandHRP converts the human-readable part into the 5-bit values, which
+// are included in the checksum.
+func expandHRP(hrp string) []byte {
+	expanded := make([]byte, 0, 2*len(hrp)+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+
+	return expanded
+}
+
+// encodeBech32 encodes the human-readable part and the 5-bit data values
+// with a checksum.
+func encodeBech32(hrp string, data []byte) (string, error) {
+	if err := validateHRP(hrp); err != nil {
+		return "", err
+	}
+	if len(hrp)+1+len(data)+checksumLength > maxBech32Length {
+		return "", fmt.Errorf("bech32 string exceeds %d characters", maxBech32Length)
+	}
+	hrp = strings.ToLower(hrp)
+
+	values := append(expandHRP(hrp), data...)
+	values = append(values, make([]byte, checksumLength)...)
+	checksum := polymod(values) ^ 1
+
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(data) + checksumLength)
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range data {
+		if v > 31 {
+			return "", fmt.Errorf("invalid 5-bit value %d", v)
+		}
+		sb.WriteByte(charset[v])
+	}
+	for i := 0; i < checksumLength; i++ {
+		sb.WriteByte(charset[(checksum>>(5*(checksumLength-1-i)))&31])
+	}
+
+	return sb.String(), nil
+}
+
+// decodeBech32 validates the bech32 string and returns its human-readable
+// part and the 5-bit data values without the checksum.
+func decodeBech32(value string) (string, []byte, error) {
+	if len(value) > maxBech32Length {
+		return "", nil, fmt.Errorf("bech32 string exceeds %d characters", maxBech32Length)
+	}
+	lower := strings.ToLower(value)
+	if lower != value && strings.ToUpper(value) != value {
+		return "", nil, errors.New("bech32 string has mixed case")
+	}
+
+	sep := strings.LastIndexByte(lower, '1')
+	if sep < 1 || sep+1+checksumLength > len(lower) {
+		return "", nil, errors.New("invalid bech32 separator position")
+	}
+	hrp := lower[:sep]
+	if err := validateHRP(hrp); err != nil {
+		return "", nil, err
+	}
+
+	data := make([]byte, 0, len(lower)-sep-1)
+	for i := sep + 1; i < len(lower); i++ {
+		v := strings.IndexByte(charset, lower[i])
+		if v < 0 {
+			return "", nil, fmt.Errorf("invalid bech32 character %q", lower[i])
+		}
+		data = append(data, byte(v))
+	}
+
+	if polymod(append(expandHRP(hrp), data...)) != 1 {
+		return "", nil, errors.New("invalid bech32 checksum")
+	}
+
+	return hrp, data[:len(data)-checksumLength], nil
+}
+
+// validateHRP checks, that the human-readable part only contains printable
+// US-ASCII characters.
+func validateHRP(hrp string) error {
+	if hrp == "" {
+		return errors.New("empty bech32 prefix")
+	}
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return fmt.Errorf("invalid character %q in bech32 prefix", hrp[i])
+		}
+	}
+
+	return nil
+}
+
+// convertBits regroups the bits of the given values from groups of fromBits
+// into groups of toBits. Padding is added to the last group, if pad is true,
+// otherwise incomplete groups must only contain zero bits.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var (
+		acc    uint32
+		bits   uint
+		result []byte
+	)
+	maxValue := uint32(1)<<toBits - 1
+	for _, v := range data {
+		if uint32(v)>>fromBits != 0 {
+			return nil, fmt.Errorf("invalid %d-bit value %d", fromBits, v)
+		}
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxValue))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(acc<<(toBits-bits)&maxValue))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxValue != 0 {
+		return nil, errors.New("invalid padding")
+	}
+
+	return result, nil
+}
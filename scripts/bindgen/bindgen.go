@@ -0,0 +1,93 @@
+// Package bindgen generates the Go bindings of contracts from the ABI and
+// bytecode files written by solc. It uses the bind package of go-ethereum
+// directly, so that the abigen CLI is not needed and the bindings can be
+// regenerated with go generate.
+package bindgen
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// ErrOutOfSync is returned, when the committed bindings differ from the
+// bindings generated from the committed artifacts.
+var ErrOutOfSync = errors.New("bindings are out of sync with the artifacts")
+
+// Contract defines the artifacts of a contract and the Go type, which is
+// generated for it.
+type Contract struct {
+	// Type is the name of the generated Go type, e.g. "Maltcoin".
+	Type string
+	// ABIPath is the path of the ABI file written by solc --abi.
+	ABIPath string
+	// BinPath is the path of the bytecode file written by solc --bin.
+	BinPath string
+}
+
+// Generate returns the source code of the Go bindings of the given
+// contracts in the package with the given name.
+func Generate(pkg string, contracts ...Contract) (string, error) {
+	if len(contracts) == 0 {
+		return "", errors.New("no contracts given")
+	}
+
+	types := make([]string, len(contracts))
+	abis := make([]string, len(contracts))
+	bins := make([]string, len(contracts))
+	for i, contract := range contracts {
+		abiJSON, err := os.ReadFile(contract.ABIPath)
+		if err != nil {
+			return "", err
+		}
+		bin, err := os.ReadFile(contract.BinPath)
+		if err != nil {
+			return "", err
+		}
+
+		types[i] = contract.Type
+		abis[i] = strings.TrimSpace(string(abiJSON))
+		// The bindings add the 0x prefix themselves
+		bins[i] = strings.TrimPrefix(strings.TrimSpace(string(bin)), "0x")
+	}
+
+	code, err := bind.Bind(types, abis, bins, nil, pkg, bind.LangGo, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate bindings: %w", err)
+	}
+
+	return code, nil
+}
+
+// Write generates the bindings of the given contracts and writes them to
+// the file at the given path.
+func Write(path, pkg string, contracts ...Contract) error {
+	code, err := Generate(pkg, contracts...)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(code), 0o644)
+}
+
+// Check generates the bindings of the given contracts and compares them
+// with the file at the given path. ErrOutOfSync is returned, if they differ.
+func Check(path, pkg string, contracts ...Contract) error {
+	code, err := Generate(pkg, contracts...)
+	if err != nil {
+		return err
+	}
+	committed, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if string(committed) != code {
+		return fmt.Errorf("%w: run go generate to update %s", ErrOutOfSync, path)
+	}
+
+	return nil
+}
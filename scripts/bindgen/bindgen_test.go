@@ -0,0 +1,66 @@
+// bindgen_test.go contains the unit tests for generating contract bindings.
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerate tests generating the bindings from the committed artifacts.
+func TestGenerate(t *testing.T) {
+	build := filepath.Join("..", "..", "contracts", "build")
+	maltcoin := Contract{
+		Type:    "Maltcoin",
+		ABIPath: filepath.Join(build, "Maltcoin.abi"),
+		BinPath: filepath.Join(build, "Maltcoin.bin"),
+	}
+
+	dir := t.TempDir()
+	invalidABIPath := filepath.Join(dir, "invalid.abi")
+	require.NoError(t, os.WriteFile(invalidABIPath, []byte("not an ABI"), 0o600))
+
+	testcases := []struct {
+		name     string
+		expErr   string
+		contract Contract
+	}{
+		{"passes - committed artifacts", "", maltcoin},
+		{"fails - missing ABI", "no such file", Contract{Type: "Maltcoin", ABIPath: filepath.Join(dir, "missing.abi"), BinPath: maltcoin.BinPath}},
+		{"fails - missing bin", "no such file", Contract{Type: "Maltcoin", ABIPath: maltcoin.ABIPath, BinPath: filepath.Join(dir, "missing.bin")}},
+		{"fails - invalid ABI", "failed to generate bindings", Contract{Type: "Maltcoin", ABIPath: invalidABIPath, BinPath: maltcoin.BinPath}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, err := Generate("maltcoin", tc.contract)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err, "Error generating bindings")
+			require.Contains(t, code, "package maltcoin")
+			require.Contains(t, code, "func DeployMaltcoin(")
+		})
+	}
+}
+
+// TestCheck tests detecting bindings, which are out of sync with the
+// artifacts.
+func TestCheck(t *testing.T) {
+	build := filepath.Join("..", "..", "contracts", "build")
+	maltcoin := Contract{
+		Type:    "Maltcoin",
+		ABIPath: filepath.Join(build, "Maltcoin.abi"),
+		BinPath: filepath.Join(build, "Maltcoin.bin"),
+	}
+	path := filepath.Join(t.TempDir(), "Maltcoin.go")
+
+	require.NoError(t, Write(path, "maltcoin", maltcoin), "Error writing bindings")
+	require.NoError(t, Check(path, "maltcoin", maltcoin), "Written bindings should be in sync")
+
+	require.NoError(t, os.WriteFile(path, []byte("package maltcoin\n"), 0o600))
+	require.ErrorIs(t, Check(path, "maltcoin", maltcoin), ErrOutOfSync)
+}
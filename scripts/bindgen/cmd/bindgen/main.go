@@ -0,0 +1,63 @@
+// bindgen generates the Go bindings of a contract from the ABI and bytecode
+// files written by solc. It is run by go generate in the package of the
+// bindings.
+//
+// Usage:
+//
+//	$ go run github.com/MalteHerrmann/GoSmartContract/scripts/bindgen/cmd/bindgen -type Maltcoin -pkg maltcoin -out Maltcoin.go
+//
+// With -check, the bindings are not written, but compared with the existing
+// file, so that outdated bindings can be detected.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/bindgen"
+)
+
+func main() {
+	typeName := flag.String("type", "", "name of the generated Go type, e.g. Maltcoin (required)")
+	pkg := flag.String("pkg", "", "name of the Go package of the bindings (required)")
+	abiPath := flag.String("abi", "", "ABI file written by solc (default: <type>.abi)")
+	binPath := flag.String("bin", "", "bytecode file written by solc (default: <type>.bin)")
+	out := flag.String("out", "", "file to write the bindings to (default: <type>.go)")
+	check := flag.Bool("check", false, "compare the existing bindings with the artifacts instead of writing them")
+	flag.Parse()
+
+	if *typeName == "" || *pkg == "" {
+		fmt.Fprintln(os.Stderr, "Error: -type and -pkg are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+	contract := bindgen.Contract{
+		Type:    *typeName,
+		ABIPath: firstNonEmpty(*abiPath, *typeName+".abi"),
+		BinPath: firstNonEmpty(*binPath, *typeName+".bin"),
+	}
+	path := firstNonEmpty(*out, *typeName+".go")
+
+	var err error
+	if *check {
+		err = bindgen.Check(path, *pkg, contract)
+	} else {
+		err = bindgen.Write(path, *pkg, contract)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// firstNonEmpty returns the first of the given values, which is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
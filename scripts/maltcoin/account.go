@@ -0,0 +1,324 @@
+// account.go contains the subcommands to manage the accounts in the
+// keystore, which holds the encrypted signing keys.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// accountOutput is a keystore account in the structured output of the
+// account subcommands. Derived accounts contain their derivation path.
+type accountOutput struct {
+	Address common.Address `json:"address"`
+	KeyFile string         `json:"key_file,omitempty"`
+	Path    string         `json:"path,omitempty"`
+}
+
+// accountListOutput is the structured output of the account-list and
+// account-derive subcommands.
+type accountListOutput struct {
+	Keystore string          `json:"keystore,omitempty"`
+	Accounts []accountOutput `json:"accounts"`
+}
+
+// mnemonicOutput is the structured output of the account-mnemonic
+// subcommand.
+type mnemonicOutput struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+// accountExportOutput is the structured output of the account-export
+// subcommand. Without --out, it contains the keystore v3 JSON or, with
+// --unsafe-hex, the private key.
+type accountExportOutput struct {
+	Address    common.Address  `json:"address"`
+	File       string          `json:"file,omitempty"`
+	Keystore   json.RawMessage `json:"keystore,omitempty"`
+	PrivateKey string          `json:"private_key,omitempty"`
+}
+
+// runAccountCreate creates a new account with a random key in the keystore.
+func runAccountCreate(args []string) error {
+	cf := newOfflineCommandFlags("account-create", "Create a new account with a random key, which is encrypted with a passphrase and stored in the keystore.")
+	ksDir := cf.keystoreFlag()
+	password := cf.passwordFlags()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	passphrase, err := password.passphrase("Passphrase for the new account: ", true)
+	if err != nil {
+		return err
+	}
+
+	ks := util.OpenKeystore(keystoreDir(*ksDir))
+	account, err := ks.NewAccount(passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to create account: %w", err)
+	}
+
+	fmt.Println("Address:  ", account.Address)
+	fmt.Println("Key file: ", account.URL.Path)
+
+	return emit(accountOutput{Address: account.Address, KeyFile: account.URL.Path})
+}
+
+// runAccountImport imports a private key or a keystore file into the
+// keystore.
+func runAccountImport(args []string) error {
+	cf := newOfflineCommandFlags("account-import", "Import a hex encoded private key or a keystore v3 file into the keystore.\n"+
+		"The key is read from a file or, if the file is \"-\", from the standard input, so that it does not\n"+
+		"appear in the command line or shell history. Keystore files keep their passphrase.")
+	keyFile := cf.String("key-file", "", "file with the hex encoded private key or the keystore v3 JSON, \"-\" for standard input (required)")
+	ksDir := cf.keystoreFlag()
+	password := cf.passwordFlags()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+	if *keyFile == "" {
+		return usageErrorf("--key-file is required")
+	}
+
+	data, err := readInput(*keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read key: %w", err)
+	}
+
+	ks := util.OpenKeystore(keystoreDir(*ksDir))
+	var address common.Address
+	if bytes.HasPrefix(data, []byte("{")) {
+		passphrase, err := password.passphrase("Passphrase of the key file: ", false)
+		if err != nil {
+			return err
+		}
+		account, err := ks.Import(data, passphrase, passphrase)
+		if err != nil && !errors.Is(err, keystore.ErrAccountAlreadyExists) {
+			return fmt.Errorf("failed to import key file: %w", err)
+		}
+		address = account.Address
+	} else {
+		privKey, err := parseHexKey(string(data))
+		if err != nil {
+			return err
+		}
+		address = crypto.PubkeyToAddress(privKey.PublicKey)
+		passphrase, err := password.passphrase("Passphrase for the imported account: ", true)
+		if err != nil {
+			return err
+		}
+		if _, err = util.ImportKey(ks, privKey, passphrase); err != nil && !errors.Is(err, keystore.ErrAccountAlreadyExists) {
+			return fmt.Errorf("failed to import key: %w", err)
+		}
+	}
+
+	fmt.Println("Imported account: ", address.Hex())
+
+	return emit(accountOutput{Address: address})
+}
+
+// runAccountMnemonic prints a new random mnemonic, from which accounts can
+// be derived with account-derive.
+func runAccountMnemonic(args []string) error {
+	cf := newOfflineCommandFlags("account-mnemonic", "Print a new random BIP-39 mnemonic. The mnemonic gives access to all accounts,\n"+
+		"which are derived from it, and has to be stored safely.")
+	bits := cf.Int("bits", util.DefaultMnemonicBits, "entropy of the mnemonic in bits (128 to 256 in steps of 32)")
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	mnemonic, err := util.NewMnemonic(*bits)
+	if err != nil {
+		return usageErrorf("--bits: %v", err)
+	}
+	if structuredOutput() {
+		return emit(mnemonicOutput{Mnemonic: mnemonic})
+	}
+	fmt.Println(mnemonic)
+
+	return nil
+}
+
+// runAccountDerive derives accounts from a mnemonic and optionally imports
+// them into the keystore.
+func runAccountDerive(args []string) error {
+	cf := newOfflineCommandFlags("account-derive", "Derive accounts from a BIP-39 mnemonic on the Ethereum path m/44'/60'/0'/0/<index>,\n"+
+		"which is also used by evmosd and common wallets. The mnemonic is read from a file or, if the\n"+
+		"file is \"-\", from the standard input, so that it does not appear in the command line or shell history.")
+	mnemonicFile := cf.String("mnemonic-file", "", "file with the mnemonic, \"-\" for standard input (env "+util.EnvMnemonic+")")
+	index := cf.Uint("index", 0, "index of the first account")
+	count := cf.Uint("count", 1, "number of accounts to derive")
+	importKeys := cf.Bool("import", false, "import the derived keys into the keystore")
+	ksDir := cf.keystoreFlag()
+	password := cf.passwordFlags()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	mnemonic := os.Getenv(util.EnvMnemonic)
+	if *mnemonicFile != "" {
+		data, err := readInput(*mnemonicFile)
+		if err != nil {
+			return fmt.Errorf("failed to read mnemonic: %w", err)
+		}
+		mnemonic = string(data)
+	}
+	if mnemonic == "" {
+		return usageErrorf("--mnemonic-file or %s is required", util.EnvMnemonic)
+	}
+	if *count == 0 {
+		return usageErrorf("--count must be positive")
+	}
+	if uint64(*index)+uint64(*count) > 1<<31 {
+		return usageErrorf("--index %d and --count %d exceed the highest account index %d", *index, *count, 1<<31-1)
+	}
+
+	seed, err := util.SeedFromMnemonic(strings.Join(strings.Fields(mnemonic), " "), "")
+	if err != nil {
+		return err
+	}
+
+	var (
+		ks         *keystore.KeyStore
+		passphrase string
+	)
+	if *importKeys {
+		if passphrase, err = password.passphrase("Passphrase for the imported accounts: ", true); err != nil {
+			return err
+		}
+		ks = util.OpenKeystore(keystoreDir(*ksDir))
+	}
+
+	output := accountListOutput{Accounts: make([]accountOutput, 0, *count)}
+	for i := uint(0); i < *count; i++ {
+		path := util.AccountPath(uint32(*index + i))
+		privKey, err := util.DeriveKey(seed, path)
+		if err != nil {
+			return err
+		}
+		address := crypto.PubkeyToAddress(privKey.PublicKey)
+		fmt.Printf("%s %s\n", path, address)
+		output.Accounts = append(output.Accounts, accountOutput{Address: address, Path: path.String()})
+		if ks == nil {
+			continue
+		}
+		if _, err := util.ImportKey(ks, privKey, passphrase); err != nil && !errors.Is(err, keystore.ErrAccountAlreadyExists) {
+			return fmt.Errorf("failed to import key of %s: %w", address, err)
+		}
+	}
+	if ks != nil {
+		output.Keystore = keystoreDir(*ksDir)
+	}
+
+	return emit(output)
+}
+
+// readInput reads the trimmed content of the given file or, if the file is
+// "-", of the standard input.
+func readInput(file string) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSpace(data), nil
+}
+
+// runAccountExport exports the key of an account in the keystore.
+func runAccountExport(args []string) error {
+	cf := newOfflineCommandFlags("account-export", "Export the key of a keystore account as keystore v3 JSON, which is encrypted with the\n"+
+		"same passphrase, or as unencrypted hex encoded private key.")
+	accountHex := cf.addressFlag("account", "address of the keystore account")
+	out := cf.String("out", "", "file to write the key to (default: standard output)")
+	unsafeHex := cf.Bool("unsafe-hex", false, "export the unencrypted hex encoded private key")
+	ksDir := cf.keystoreFlag()
+	password := cf.passwordFlags()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	address, err := parseAddress("account", *accountHex)
+	if err != nil {
+		return err
+	}
+	ks := util.OpenKeystore(keystoreDir(*ksDir))
+	account, err := util.FindAccount(ks, address)
+	if err != nil {
+		return err
+	}
+	passphrase, err := password.passphrase(fmt.Sprintf("Passphrase of %s: ", address), false)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if *unsafeHex {
+		privKey, err := util.ExportKey(ks, address, passphrase)
+		if err != nil {
+			return err
+		}
+		data = []byte(fmt.Sprintf("%x\n", crypto.FromECDSA(privKey)))
+	} else {
+		if data, err = ks.Export(account, passphrase, passphrase); err != nil {
+			return fmt.Errorf("failed to export key: %w", err)
+		}
+		data = append(data, '\n')
+	}
+
+	switch {
+	case *out != "":
+		if err := os.WriteFile(*out, data, 0o600); err != nil {
+			return err
+		}
+		return emit(accountExportOutput{Address: address, File: *out})
+	case !structuredOutput():
+		_, err = os.Stdout.Write(data)
+		return err
+	case *unsafeHex:
+		return emit(accountExportOutput{Address: address, PrivateKey: string(bytes.TrimSpace(data))})
+	default:
+		return emit(accountExportOutput{Address: address, Keystore: bytes.TrimSpace(data)})
+	}
+}
+
+// runAccountList lists the accounts in the keystore.
+func runAccountList(args []string) error {
+	cf := newOfflineCommandFlags("account-list", "List the addresses and key files of all accounts in the keystore.")
+	ksDir := cf.keystoreFlag()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	dir := keystoreDir(*ksDir)
+	accounts := util.OpenKeystore(dir).Accounts()
+	if len(accounts) == 0 {
+		fmt.Printf("There are no accounts in the keystore %s.\n", dir)
+	}
+
+	output := accountListOutput{Keystore: dir, Accounts: make([]accountOutput, 0, len(accounts))}
+	for i, account := range accounts {
+		keyFile := strings.TrimPrefix(account.URL.Path, dir+string(os.PathSeparator))
+		fmt.Printf("#%d: %s %s\n", i, account.Address, keyFile)
+		output.Accounts = append(output.Accounts, accountOutput{Address: account.Address, KeyFile: keyFile})
+	}
+
+	return emit(output)
+}
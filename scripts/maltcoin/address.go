@@ -0,0 +1,52 @@
+// address.go contains the address subcommand, which converts addresses
+// between the hex and the bech32 representation.
+package main
+
+import (
+	"fmt"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/address"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// addressOutput is the structured output of the address subcommand.
+type addressOutput struct {
+	Hex    common.Address `json:"hex"`
+	Bech32 string         `json:"bech32"`
+}
+
+// runAddress prints the hex and bech32 representations of an address.
+func runAddress(args []string) error {
+	cf := newOfflineCommandFlags("address", "Print the EIP-55 hex and the bech32 representation of an address.\n"+
+		"The human-readable part of bech32 addresses is read from "+address.EnvHRP+" (default \""+address.DefaultHRP+"\").")
+	value := cf.addressFlag("address", "address to convert")
+	hexOnly := cf.Bool("hex", false, "only print the hex address")
+	bech32Only := cf.Bool("bech32", false, "only print the bech32 address")
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+	if *hexOnly && *bech32Only {
+		return usageErrorf("--hex and --bech32 are mutually exclusive")
+	}
+
+	parsed, err := parseAddress("address", *value)
+	if err != nil {
+		return err
+	}
+	bech32Address, err := address.Bech32(parsed, bech32Prefix())
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case *hexOnly:
+		fmt.Println(parsed.Hex())
+	case *bech32Only:
+		fmt.Println(bech32Address)
+	default:
+		fmt.Println("Hex:    ", parsed.Hex())
+		fmt.Println("Bech32: ", bech32Address)
+	}
+
+	return emit(addressOutput{Hex: parsed, Bech32: bech32Address})
+}
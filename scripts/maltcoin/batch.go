@@ -0,0 +1,172 @@
+// batch.go contains the batch-transfer subcommand, which distributes tokens
+// to the recipients listed in a CSV file.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// batchOutput is the structured output of the batch-transfer subcommand.
+// It contains the state of every transfer in the journal after the run.
+type batchOutput struct {
+	Contract  common.Address `json:"contract"`
+	Sender    common.Address `json:"sender"`
+	Journal   string         `json:"journal"`
+	Total     amountOutput   `json:"outstanding_amount"`
+	Confirmed int            `json:"confirmed"`
+	Failed    int            `json:"failed"`
+	Skipped   int            `json:"skipped"`
+	Transfers []batchEntry   `json:"transfers"`
+}
+
+// batchEntry is the state of a transfer of a batch.
+type batchEntry struct {
+	Row       int            `json:"row"`
+	Recipient common.Address `json:"recipient"`
+	Amount    amountOutput   `json:"amount"`
+	Status    string         `json:"status"`
+	TxHash    *common.Hash   `json:"tx_hash,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// runBatchTransfer transfers tokens to all recipients in the given batch
+// file. The progress is recorded in a journal, so that the command can be
+// run again to resume an interrupted batch.
+func runBatchTransfer(args []string) error {
+	tf := newTransactionFlags("batch-transfer", "Transfer tokens to all recipients in a CSV file with address,amount rows. "+
+		"The amounts are given in whole tokens or, with --raw, in the smallest unit. Every transfer is recorded in a journal file, "+
+		"so that an interrupted batch can be resumed by running the command again.")
+	file := tf.String("file", "", "CSV file with address,amount rows (required)")
+	journalPath := tf.String("journal", "", "journal file of the batch (default: <file>.journal)")
+	concurrency := tf.Int("concurrency", 4, "maximum number of transfers waiting for confirmation at the same time")
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	if *file == "" {
+		return usageErrorf("--file is required")
+	}
+	if *concurrency < 1 {
+		return usageErrorf("--concurrency must be positive")
+	}
+	if *journalPath == "" {
+		*journalPath = *file + ".journal"
+	}
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	profile, err := tf.profile()
+	if err != nil {
+		return err
+	}
+	waitOptions, err := tf.wait.options(profile)
+	if err != nil {
+		return err
+	}
+
+	client, auth, err := util.GetClientAndTransactionSigner(profile, privKey)
+	if err != nil {
+		return fmt.Errorf("error while connecting to the node and getting the transaction signer: %w", err)
+	}
+	defer client.Close()
+
+	// All rows are validated with the unit of the token, before any
+	// transfer is sent
+	unit, err := tokenUnit(client, contractAddress, *tf.raw)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	transfers, err := util.ReadBatchTransfers(f, bech32Prefix(), unit)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("invalid batch file %s: %w", *file, err)
+	}
+
+	journal, err := util.OpenJournal(*journalPath, contractAddress, auth.From)
+	if err != nil {
+		return fmt.Errorf("failed to open journal: %w", err)
+	}
+	defer journal.Close()
+
+	total, err := util.ValidateBatch(client, contractAddress, auth.From, transfers, journal)
+	if err != nil {
+		return err
+	}
+
+	printHeader("maltcoin batch-transfer", fmt.Sprintf("Transfers tokens to the recipients in %s on the %q network.", *file, profile.Name))
+	fmt.Println("Maltcoin contract:  ", contractAddress)
+	fmt.Println("Sender:             ", auth.From)
+	fmt.Println("Transfers:          ", len(transfers))
+	fmt.Println("Outstanding amount: ", util.NewTokenAmount(total, unit))
+	fmt.Println("Journal:            ", *journalPath)
+	fmt.Println()
+
+	// Interrupting the batch leaves the unconfirmed transfers pending,
+	// so that they are reconciled, when the batch is resumed.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	opts := util.BatchOptions{
+		Concurrency: *concurrency,
+		Fees:        profile.FeeOptions(),
+		Wait:        waitOptions,
+		OnUpdate: func(entry util.JournalEntry) {
+			txHash := ""
+			if entry.TxHash != nil {
+				txHash = entry.TxHash.Hex()
+			}
+			fmt.Printf("Row %-5d %-9s %v to %s %s %s\n", entry.Row, entry.Status, util.NewTokenAmount(entry.Amount, unit), entry.Recipient, txHash, entry.Error)
+		},
+	}
+	summary, err := util.SendBatch(ctx, client, auth, contractAddress, transfers, journal, opts)
+	if summary != nil {
+		fmt.Printf("\nConfirmed: %d, failed: %d, skipped (confirmed before): %d\n", summary.Confirmed, summary.Failed, summary.Skipped)
+	}
+	if err != nil {
+		return fmt.Errorf("batch was not completed, run the command again to resume: %w", err)
+	}
+
+	output := batchOutput{
+		Contract:  contractAddress,
+		Sender:    auth.From,
+		Journal:   *journalPath,
+		Total:     newAmountOutput(total, unit),
+		Confirmed: summary.Confirmed,
+		Failed:    summary.Failed,
+		Skipped:   summary.Skipped,
+		Transfers: make([]batchEntry, 0, len(transfers)),
+	}
+	for _, transfer := range transfers {
+		entry, ok := journal.Entry(transfer.Row)
+		if !ok {
+			continue
+		}
+		output.Transfers = append(output.Transfers, batchEntry{
+			Row:       entry.Row,
+			Recipient: entry.Recipient,
+			Amount:    newAmountOutput(entry.Amount, unit),
+			Status:    entry.Status,
+			TxHash:    entry.TxHash,
+			Error:     entry.Error,
+		})
+	}
+	if err := emit(output); err != nil {
+		return err
+	}
+	if summary.Failed > 0 {
+		return fmt.Errorf("%d transfer(s) failed, run the command again to retry them", summary.Failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,526 @@
+// bootstrap.go contains the bootstrap subcommand, which deploys a token
+// contract and checks it end-to-end in explicit steps.
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/address"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// bootstrapOutput is the summary of the bootstrap subcommand. The parts of
+// steps, which did not run, are null.
+type bootstrapOutput struct {
+	Network    string             `json:"network"`
+	Deployer   common.Address     `json:"deployer"`
+	Recipient  common.Address     `json:"recipient"`
+	Artifact   string             `json:"artifact"`
+	Contract   *common.Address    `json:"contract"`
+	Deployment *transactionOutput `json:"deployment"`
+	CodeSize   int                `json:"code_size"`
+	Token      *tokenOutput       `json:"token"`
+	Transfer   *smokeTransfer     `json:"transfer"`
+	Steps      []stepOutput       `json:"steps"`
+}
+
+// tokenOutput describes the deployed token.
+type tokenOutput struct {
+	Name        string       `json:"name"`
+	Symbol      string       `json:"symbol"`
+	Decimals    uint8        `json:"decimals"`
+	TotalSupply amountOutput `json:"total_supply"`
+}
+
+// smokeTransfer describes the transfer, which checks the deployed token.
+type smokeTransfer struct {
+	To               common.Address     `json:"to"`
+	Amount           amountOutput       `json:"amount"`
+	Transaction      *transactionOutput `json:"transaction"`
+	RecipientBalance *amountOutput      `json:"recipient_balance"`
+}
+
+// stepOutput is the outcome of a bootstrap step.
+type stepOutput struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+// evmosdFlags contains the flags, which load the keys from the keyring of
+// the evmosd CLI.
+type evmosdFlags struct {
+	binary         *string
+	keyringBackend *string
+	key            *string
+	recipient      *string
+}
+
+// run executes the evmosd CLI with the given arguments and returns its
+// trimmed output.
+func (ef *evmosdFlags) run(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, *ef.binary, append(args, "--keyring-backend", *ef.keyringBackend)...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s %s failed: %w: %s", *ef.binary, strings.Join(args[:2], " "), err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// bootstrap contains the state, which is passed between the steps.
+type bootstrap struct {
+	signer   *signerFlags
+	evmosd   *evmosdFlags
+	profile  util.NetworkProfile
+	wait     util.WaitOptions
+	settings util.TokenSettings
+
+	privKey   *ecdsa.PrivateKey
+	recipient common.Address
+	artifact  *util.Artifact
+	client    *ethclient.Client
+	auth      *bind.TransactOpts
+	contract  *maltcoin.Maltcoin
+	unit      util.TokenUnit
+	amount    *big.Int
+	deployTx  *types.Transaction
+	transfer  *types.Transaction
+	before    *big.Int
+	output    bootstrapOutput
+}
+
+// runBootstrap deploys the token contract and checks the deployment with a
+// transfer. Every step is retried and the outcome of all steps is reported.
+func runBootstrap(args []string) error {
+	cf := newCommandFlags("bootstrap", "Deploy a Maltcoin token contract and check it end-to-end: load the keys, load or compile "+
+		"the contract, deploy it, wait for the confirmation, verify the deployed code and send a transfer to a recipient. "+
+		"Failed steps are retried and a summary of all steps is printed.")
+	signer := cf.signerFlags()
+	wait := cf.waitFlags()
+	evmosd := &evmosdFlags{
+		binary:         cf.String("evmosd", "evmosd", "path of the evmosd binary"),
+		keyringBackend: cf.String("keyring-backend", "test", "keyring backend of evmosd"),
+		key:            cf.String("evmosd-key", "", "name of the evmosd key to import into the keystore and sign with"),
+		recipient:      cf.String("evmosd-recipient", "", "name of the evmosd key, which receives the transfer"),
+	}
+	recipientHex := cf.String("to", "", "address of the recipient of the transfer, hex or bech32")
+	amountStr := cf.String("amount", "1", "amount of tokens to transfer, e.g. 1.5 or 1.5MALT")
+	raw := cf.rawFlag()
+	token := cf.tokenFlags(false)
+	artifact := cf.artifactFlags("deploy")
+	retryDefaults := util.DefaultRetryOptions()
+	attempts := cf.Int("retries", retryDefaults.Attempts, "maximum number of attempts of every step")
+	retryDelay := cf.Duration("retry-delay", retryDefaults.Delay, "time between two attempts of a step")
+	summaryPath := cf.String("summary", "", "file to write the summary to as JSON")
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	if *evmosd.key != "" && (*signer.account != "" || os.Getenv(EnvAccount) != "") {
+		return usageErrorf("--evmosd-key and --account are mutually exclusive")
+	}
+	switch {
+	case *evmosd.recipient != "" && *recipientHex != "":
+		return usageErrorf("--to and --evmosd-recipient are mutually exclusive")
+	case *evmosd.recipient == "" && *recipientHex == "":
+		return usageErrorf("--to or --evmosd-recipient is required")
+	}
+	if err := artifact.validate(); err != nil {
+		return err
+	}
+	settings, err := token.settings(*raw)
+	if err != nil {
+		return err
+	}
+	if err := requireAmount("amount", *amountStr); err != nil {
+		return err
+	}
+	if *attempts < 1 {
+		return usageErrorf("--retries must be positive")
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+	waitOptions, err := wait.options(profile)
+	if err != nil {
+		return err
+	}
+
+	b := &bootstrap{
+		signer:   signer,
+		evmosd:   evmosd,
+		profile:  profile,
+		wait:     waitOptions,
+		settings: settings,
+		output:   bootstrapOutput{Network: profile.Name, Artifact: artifact.kind()},
+	}
+	defer func() {
+		if b.client != nil {
+			b.client.Close()
+		}
+	}()
+
+	steps := []util.Step{
+		{Name: "load keys", Run: func(ctx context.Context) error { return b.loadKeys(ctx, *recipientHex) }},
+		{Name: "load artifacts", Run: func(ctx context.Context) error { return b.loadArtifact(artifact.load(ctx)) }},
+		{Name: "connect", Run: b.connect},
+		{Name: "deploy", Run: b.deploy},
+		{Name: "confirm deployment", Run: b.confirmDeployment},
+		{Name: "verify code", Run: func(ctx context.Context) error { return b.verify(ctx, *amountStr, *raw) }},
+		{Name: "send transfer", Run: b.sendTransfer},
+		{Name: "confirm transfer", Run: b.confirmTransfer},
+	}
+
+	printHeader("maltcoin bootstrap", fmt.Sprintf("Deploys and checks a Maltcoin token contract on the %q network.", profile.Name))
+
+	// Interrupting the bootstrap stops the current step and reports the
+	// steps, which were run so far.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	results, runErr := util.RunSteps(ctx, steps, util.RetryOptions{
+		Attempts: *attempts,
+		Delay:    *retryDelay,
+		OnRetry: func(step string, attempt int, err error) {
+			fmt.Printf("Step %q failed in attempt %d, retrying in %v: %v\n", step, attempt, *retryDelay, err)
+		},
+	})
+	for _, result := range results {
+		b.output.Steps = append(b.output.Steps, stepOutput{
+			Name:     result.Name,
+			Status:   result.Status,
+			Attempts: result.Attempts,
+			Duration: result.Duration.String(),
+			Error:    result.Error,
+		})
+	}
+
+	b.printSummary()
+	if *summaryPath != "" {
+		if err := writeSummary(*summaryPath, b.output); err != nil {
+			return fmt.Errorf("failed to write summary: %w", err)
+		}
+		fmt.Println("Summary written to: ", *summaryPath)
+	}
+	if err := emit(b.output); err != nil {
+		return err
+	}
+
+	return runErr
+}
+
+// loadKeys loads the key of the signer and the address of the recipient.
+// Keys of evmosd are imported into the keystore.
+func (b *bootstrap) loadKeys(ctx context.Context, recipientHex string) error {
+	if *b.evmosd.key != "" {
+		hexKey, err := b.evmosd.run(ctx, "keys", "unsafe-export-eth-key", *b.evmosd.key)
+		if err != nil {
+			return err
+		}
+		if b.privKey, err = parseHexKey(hexKey); err != nil {
+			return util.Permanent(err)
+		}
+		passphrase, err := b.signer.passphrase("Passphrase for the imported account: ", true)
+		if err != nil {
+			return util.Permanent(err)
+		}
+		ks := util.OpenKeystore(keystoreDir(*b.signer.keystore))
+		if _, err := util.ImportKey(ks, b.privKey, passphrase); err != nil && !errors.Is(err, keystore.ErrAccountAlreadyExists) {
+			return fmt.Errorf("failed to import key: %w", err)
+		}
+	} else {
+		privKey, err := b.signer.privateKey()
+		if err != nil {
+			return util.Permanent(err)
+		}
+		b.privKey = privKey
+	}
+	b.output.Deployer = crypto.PubkeyToAddress(b.privKey.PublicKey)
+
+	if *b.evmosd.recipient != "" {
+		bech32Address, err := b.evmosd.run(ctx, "keys", "show", *b.evmosd.recipient, "-a")
+		if err != nil {
+			return err
+		}
+		recipientHex = bech32Address
+	}
+	recipient, err := address.Parse(recipientHex, bech32Prefix())
+	if err != nil {
+		return util.Permanent(fmt.Errorf("invalid recipient: %w", err))
+	}
+	b.recipient, b.output.Recipient = recipient, recipient
+	fmt.Println("Deployer:  ", b.output.Deployer)
+	fmt.Println("Recipient: ", b.recipient)
+
+	return nil
+}
+
+// loadArtifact checks, that the loaded artifact can be used with the
+// Maltcoin bindings.
+func (b *bootstrap) loadArtifact(artifact *util.Artifact, err error) error {
+	if err != nil {
+		return err
+	}
+	maltcoinABI, err := util.GetMaltcoinABI()
+	if err != nil {
+		return util.Permanent(err)
+	}
+	if err := artifact.Implements(maltcoinABI); err != nil {
+		return util.Permanent(err)
+	}
+	b.artifact = artifact
+	fmt.Printf("Loaded %s artifact (%d bytes of bytecode)\n", b.output.Artifact, len(artifact.Bin))
+
+	return nil
+}
+
+// connect connects to the node. Retrying the step waits for a node, which
+// is still starting.
+func (b *bootstrap) connect(context.Context) error {
+	client, auth, err := util.GetClientAndTransactionSigner(b.profile, b.privKey)
+	if errors.Is(err, util.ErrChainIDMismatch) {
+		return util.Permanent(err)
+	}
+	if err != nil {
+		return err
+	}
+	b.client, b.auth = client, auth
+
+	return nil
+}
+
+// deploy signs the deployment transaction in the first attempt and
+// broadcasts it. Retrying the step broadcasts the same transaction again,
+// so that the contract is not deployed twice, if the node received the
+// transaction, although sending it failed.
+func (b *bootstrap) deploy(ctx context.Context) error {
+	if b.deployTx == nil {
+		opts := *b.auth
+		opts.NoSend = true
+		contractAddress, tx, err := util.DeployArtifact(&opts, b.client, b.artifact, b.profile.FeeOptions(), b.settings.Args()...)
+		if errors.Is(err, util.ErrExecutionReverted) {
+			return util.Permanent(err)
+		}
+		if err != nil {
+			return err
+		}
+		b.deployTx = tx
+		b.output.Contract = &contractAddress
+		deployment := newTransactionOutput(tx, b.auth.From, nil)
+		b.output.Deployment = &deployment
+		fmt.Printf("Deploying to %s in transaction %s (fee mode: %s)\n", contractAddress, tx.Hash().Hex(), util.DescribeFees(&opts))
+	}
+
+	return b.broadcast(ctx, b.deployTx)
+}
+
+// confirmDeployment waits for the confirmation of the deployment. Retrying
+// the step waits for the same transaction again.
+func (b *bootstrap) confirmDeployment(context.Context) error {
+	receipt, err := waitForTransaction(b.client, b.deployTx, b.wait, b.artifact.ABI)
+	if errors.Is(err, util.ErrTransactionFailed) {
+		return util.Permanent(err)
+	}
+	if err != nil {
+		return err
+	}
+	deployment := newTransactionOutput(b.deployTx, b.auth.From, receipt)
+	b.output.Deployment = &deployment
+
+	return nil
+}
+
+// verify checks, that the deployed code matches the artifact and that the
+// deployer holds the initial supply of the token.
+func (b *bootstrap) verify(ctx context.Context, amountStr string, raw bool) error {
+	contractAddress := *b.output.Contract
+	expected, err := b.artifact.RuntimeCode(b.settings.Args()...)
+	if err != nil {
+		return util.Permanent(err)
+	}
+	verification, err := util.VerifyCode(ctx, b.client, contractAddress, expected)
+	if err != nil {
+		return err
+	}
+	b.output.CodeSize = verification.ActualSize
+	if err := verification.Err(); err != nil {
+		return util.Permanent(err)
+	}
+	if b.contract, err = maltcoin.NewMaltcoin(contractAddress, b.client); err != nil {
+		return util.Permanent(err)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	name, err := b.contract.Name(opts)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve token name: %w", err)
+	}
+	if b.unit, err = util.GetTokenUnit(opts, b.client, contractAddress); err != nil {
+		return err
+	}
+	b.unit.Raw = raw
+	totalSupply, err := b.contract.TotalSupply(opts)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve total supply: %w", err)
+	}
+	balance, err := b.contract.BalanceOf(opts, b.auth.From)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve balance: %w", err)
+	}
+	b.output.Token = &tokenOutput{
+		Name:        name,
+		Symbol:      b.unit.Symbol,
+		Decimals:    b.unit.Decimals,
+		TotalSupply: newAmountOutput(totalSupply, b.unit),
+	}
+	if balance.Cmp(totalSupply) != 0 {
+		return util.Permanent(fmt.Errorf("deployer holds %v instead of the total supply of %v", util.NewTokenAmount(balance, b.unit), util.NewTokenAmount(totalSupply, b.unit)))
+	}
+
+	if b.amount, err = parseAmount("amount", amountStr, b.unit); err != nil {
+		return util.Permanent(err)
+	}
+	b.output.Transfer = &smokeTransfer{To: b.recipient, Amount: newAmountOutput(b.amount, b.unit)}
+	fmt.Printf("Verified %d bytes of code of %s (%s) with a total supply of %v\n", verification.ActualSize, name, b.unit.Symbol, util.NewTokenAmount(totalSupply, b.unit))
+
+	return nil
+}
+
+// sendTransfer signs the transfer of tokens from the deployer to the
+// recipient in the first attempt and broadcasts it. Like for the deployment,
+// retrying the step broadcasts the same transaction again.
+func (b *bootstrap) sendTransfer(ctx context.Context) error {
+	if b.transfer == nil {
+		before, err := b.contract.BalanceOf(&bind.CallOpts{Context: ctx}, b.recipient)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve balance: %w", err)
+		}
+		b.before = before
+
+		opts := *b.auth
+		opts.NoSend = true
+		tx, err := util.TransferTokens(&opts, b.client, *b.output.Contract, b.recipient, b.amount, b.profile.FeeOptions())
+		if errors.Is(err, util.ErrExecutionReverted) {
+			return util.Permanent(err)
+		}
+		if err != nil {
+			return err
+		}
+		b.transfer = tx
+		transaction := newTransactionOutput(tx, b.auth.From, nil)
+		b.output.Transfer.Transaction = &transaction
+		fmt.Printf("Transferring %v to %s in transaction %s\n", util.NewTokenAmount(b.amount, b.unit), b.recipient, tx.Hash().Hex())
+	}
+
+	return b.broadcast(ctx, b.transfer)
+}
+
+// broadcast sends the signed transaction to the node. A transaction, which
+// the node already knows or which was already executed, was sent in a
+// previous attempt. If its nonce was used by another transaction instead,
+// the transaction can never be executed and the step fails permanently.
+func (b *bootstrap) broadcast(ctx context.Context, tx *types.Transaction) error {
+	err := b.client.SendTransaction(ctx, tx)
+	switch {
+	case err == nil, util.IsAlreadyKnown(err):
+		return nil
+	case util.IsNonceError(err):
+		_, receiptErr := b.client.TransactionReceipt(ctx, tx.Hash())
+		if receiptErr == nil {
+			return nil
+		}
+		if !errors.Is(receiptErr, ethereum.NotFound) {
+			return receiptErr
+		}
+		return util.Permanent(fmt.Errorf("nonce %d of transaction %s was used by another transaction: %w", tx.Nonce(), tx.Hash().Hex(), err))
+	}
+
+	return fmt.Errorf("failed to broadcast transaction %s: %w", tx.Hash().Hex(), err)
+}
+
+// confirmTransfer waits for the confirmation of the transfer and checks the
+// balance of the recipient.
+func (b *bootstrap) confirmTransfer(ctx context.Context) error {
+	receipt, err := waitForTransaction(b.client, b.transfer, b.wait)
+	if errors.Is(err, util.ErrTransactionFailed) {
+		return util.Permanent(err)
+	}
+	if err != nil {
+		return err
+	}
+	transaction := newTransactionOutput(b.transfer, b.auth.From, receipt)
+	b.output.Transfer.Transaction = &transaction
+
+	balance, err := b.contract.BalanceOf(&bind.CallOpts{Context: ctx}, b.recipient)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve balance: %w", err)
+	}
+	recipientBalance := newAmountOutput(balance, b.unit)
+	b.output.Transfer.RecipientBalance = &recipientBalance
+	if expected := new(big.Int).Add(b.before, b.amount); balance.Cmp(expected) != 0 {
+		return util.Permanent(fmt.Errorf("recipient holds %v instead of %v", util.NewTokenAmount(balance, b.unit), util.NewTokenAmount(expected, b.unit)))
+	}
+
+	return nil
+}
+
+// printSummary prints the outcome of the steps and the deployed contract.
+func (b *bootstrap) printSummary() {
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tSTATUS\tATTEMPTS\tDURATION\tERROR")
+	for _, step := range b.output.Steps {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", step.Name, step.Status, step.Attempts, step.Duration, step.Error)
+	}
+	w.Flush()
+	fmt.Println()
+
+	if b.output.Contract != nil {
+		fmt.Println("Contract address:   ", *b.output.Contract)
+	}
+	if b.output.Deployment != nil {
+		fmt.Println("Deployment:         ", b.output.Deployment.Hash.Hex())
+	}
+	if b.output.Token != nil {
+		fmt.Println("Token:              ", b.output.Token.Name, "("+b.output.Token.Symbol+")")
+		fmt.Println("Code size:          ", b.output.CodeSize)
+	}
+	if b.output.Transfer != nil && b.output.Transfer.RecipientBalance != nil {
+		fmt.Println("Transfer:           ", b.output.Transfer.Transaction.Hash.Hex())
+		fmt.Println("Recipient balance:  ", b.output.Transfer.RecipientBalance)
+	}
+}
+
+// writeSummary writes the summary as indented JSON to the given file.
+func writeSummary(path string, output bootstrapOutput) error {
+	bz, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(bz, '\n'), 0o644)
+}
@@ -0,0 +1,195 @@
+// call.go contains the call subcommand, which calls an arbitrary method
+// of a contract ABI.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// callOutput is the structured output of the call subcommand. Read-only
+// calls contain the return values of the method, transactions the sent
+// transaction.
+type callOutput struct {
+	Contract    common.Address     `json:"contract"`
+	Method      string             `json:"method"`
+	ReadOnly    bool               `json:"read_only"`
+	Outputs     []callValue        `json:"outputs,omitempty"`
+	Transaction *transactionOutput `json:"transaction,omitempty"`
+}
+
+// callValue is a decoded return value of a method.
+type callValue struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// runCall calls a contract method with arguments, that are parsed from the
+// command line. Constant methods are executed as read-only calls, all other
+// methods are sent as signed transactions.
+func runCall(args []string) error {
+	cf := newCommandFlags("call", "Call any method of the Maltcoin ABI or of the ABI in the given file. View and pure methods\n"+
+		"are executed as read-only calls, all other methods are sent as signed transactions.\n\n"+
+		"Arguments are given in order with --arg. Arrays are written as [a,b,c] and bytes as hex.")
+	contractHex := cf.addressFlag("contract", "address of the contract")
+	method := cf.String("method", "", "name of the contract method (required)")
+	abiPath := cf.String("abi", "", "path to a JSON ABI file (default: Maltcoin ABI)")
+	fromHex := cf.String("from", "", "sender address for read-only calls (default: address of the signer)")
+	readOnly := cf.Bool("read-only", false, "execute a non-constant method as read-only call without sending a transaction")
+	signer := cf.signerFlags()
+	wait := cf.waitFlags()
+	var methodArgs stringList
+	cf.Var(&methodArgs, "arg", "method argument, repeat for multiple arguments")
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	if *method == "" {
+		return usageErrorf("--method is required")
+	}
+
+	// Load the ABI and convert the arguments into the method input types
+	var contractABI *abi.ABI
+	if *abiPath != "" {
+		contractABI, err = util.LoadABI(*abiPath)
+	} else {
+		contractABI, err = util.GetMaltcoinABI()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load ABI: %w", err)
+	}
+	abiMethod, err := util.GetMethod(contractABI, *method)
+	if err != nil {
+		return usageErrorf("--method: %v", err)
+	}
+	callArgs, err := util.ParseArgs(abiMethod.Inputs, methodArgs, bech32Prefix())
+	if err != nil {
+		return usageErrorf("--arg: %v (signature: %s)", err, abiMethod.Sig)
+	}
+
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	if abiMethod.IsConstant() || *readOnly {
+		var from common.Address
+		switch {
+		case *fromHex != "":
+			if from, err = parseAddress("from", *fromHex); err != nil {
+				return err
+			}
+		default:
+			// The key of the signer is not needed for read-only calls
+			if from, _, err = signer.address(); err != nil {
+				return err
+			}
+		}
+
+		client, err := util.GetClient(profile)
+		if err != nil {
+			return fmt.Errorf("failed to connect to node: %w", err)
+		}
+		defer client.Close()
+
+		outputs, err := util.CallMethod(context.Background(), client, contractABI, contractAddress, from, *method, callArgs, nil)
+		if err != nil {
+			return fmt.Errorf("call of %s failed: %w", abiMethod.Sig, err)
+		}
+
+		values := callValues(abiMethod.Outputs, outputs)
+		printHeader("maltcoin call", fmt.Sprintf("Calls %s on contract %s.", abiMethod.Sig, contractAddress))
+		printOutputs(values)
+		return emit(callOutput{Contract: contractAddress, Method: abiMethod.Sig, ReadOnly: true, Outputs: values})
+	}
+
+	privKey, err := signer.privateKey()
+	if err != nil {
+		return err
+	}
+	waitOptions, err := wait.options(profile)
+	if err != nil {
+		return err
+	}
+
+	client, auth, err := util.GetClientAndTransactionSigner(profile, privKey)
+	if err != nil {
+		return fmt.Errorf("error while connecting to the node and getting the transaction signer: %w", err)
+	}
+	defer client.Close()
+
+	callData, err := contractABI.Pack(*method, callArgs...)
+	if err != nil {
+		return fmt.Errorf("error while getting the call data: %w", err)
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   &contractAddress,
+		Data: callData,
+	}
+	auth, err = util.FillTransactionSignerFieldsWithFees(auth, client, callMsg, profile.FeeOptions())
+	if err != nil {
+		// Reverts are decoded with the Maltcoin ABI, so that the custom
+		// errors of another ABI have to be decoded again.
+		var unknownErr *util.UnknownRevertError
+		if errors.As(err, &unknownErr) {
+			err = util.DecodeRevert(unknownErr.Data, contractABI)
+		}
+		return fmt.Errorf("error while filling the transaction signer fields: %w", err)
+	}
+
+	tx, err := util.TransactMethod(auth, client, contractABI, contractAddress, *method, callArgs)
+	if err != nil {
+		return fmt.Errorf("transaction calling %s failed: %w", abiMethod.Sig, err)
+	}
+
+	printHeader("maltcoin call", fmt.Sprintf("Sends a transaction calling %s on contract %s.", abiMethod.Sig, contractAddress))
+	receipt, err := waitForTransaction(client, tx, waitOptions, contractABI)
+	if err != nil {
+		return err
+	}
+
+	transaction := newTransactionOutput(tx, auth.From, receipt)
+	return emit(callOutput{Contract: contractAddress, Method: abiMethod.Sig, Transaction: &transaction})
+}
+
+// callValues returns the decoded return values of a method together with
+// their names and types.
+func callValues(arguments abi.Arguments, outputs []interface{}) []callValue {
+	values := make([]callValue, len(outputs))
+	for i, output := range outputs {
+		name, typ := fmt.Sprintf("output%d", i), "unknown"
+		if i < len(arguments) {
+			typ = arguments[i].Type.String()
+			if arguments[i].Name != "" {
+				name = arguments[i].Name
+			}
+		}
+		values[i] = callValue{Name: name, Type: typ, Value: util.FormatValue(output)}
+	}
+
+	return values
+}
+
+// printOutputs prints the return values of a method.
+func printOutputs(values []callValue) {
+	if len(values) == 0 {
+		fmt.Println("The method returned no values.")
+		return
+	}
+
+	for _, value := range values {
+		fmt.Printf("%s (%s): %s\n", value.Name, value.Type, value.Value)
+	}
+}
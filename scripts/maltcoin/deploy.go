@@ -0,0 +1,411 @@
+// deploy.go contains the deploy subcommand, which deploys an instance of
+// the Maltcoin token contract, and the deploy-factory subcommand, which
+// deploys the factory for deterministic deployments.
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// deployOutput is the structured output of the deploy subcommand. The
+// transaction is null, if a deterministic deployment found an existing
+// contract.
+type deployOutput struct {
+	Network     string             `json:"network"`
+	Contract    common.Address     `json:"contract"`
+	Token       tokenOutput        `json:"token"`
+	Holder      common.Address     `json:"holder"`
+	Admin       *common.Address    `json:"admin,omitempty"`
+	Transaction *transactionOutput `json:"transaction"`
+	Create2     *create2Output     `json:"create2,omitempty"`
+}
+
+// newDeployOutput returns the output of a deployment with the given token
+// settings, which was sent by the given deployer.
+func newDeployOutput(network string, contractAddress common.Address, settings util.TokenSettings, deployer common.Address) deployOutput {
+	unit := settings.Unit()
+	return deployOutput{
+		Network:  network,
+		Contract: contractAddress,
+		Token: tokenOutput{
+			Name:        settings.Name,
+			Symbol:      settings.Symbol,
+			Decimals:    unit.Decimals,
+			TotalSupply: newAmountOutput(settings.InitialSupply, unit),
+		},
+		Holder: settings.Holder(deployer),
+	}
+}
+
+// create2Output describes a deterministic deployment through the factory.
+type create2Output struct {
+	Factory      common.Address `json:"factory"`
+	Salt         common.Hash    `json:"salt"`
+	InitCodeHash common.Hash    `json:"init_code_hash"`
+	Existing     bool           `json:"existing"`
+}
+
+// factoryOutput is the structured output of the deploy-factory subcommand.
+type factoryOutput struct {
+	Network     string            `json:"network"`
+	Factory     common.Address    `json:"factory"`
+	Transaction transactionOutput `json:"transaction"`
+}
+
+// runDeploy deploys the token contract using the signer's private key.
+func runDeploy(args []string) error {
+	cf := newCommandFlags("deploy", "Deploy a new Maltcoin token contract. The name, symbol and initial supply of the token "+
+		"are given with flags and the initial supply is assigned to the signer or to --holder. With --create2, the contract is deployed through the factory, so that its address only depends on the factory, "+
+		"the salt and the contract bytecode, and the deployment is skipped, if the contract already exists. With --managed, the variant "+
+		"with minting, burning, pausing and roles is deployed, whose roles are granted to the signer or to --admin.")
+	signer := cf.signerFlags()
+	wait := cf.waitFlags()
+	token := cf.tokenFlags(true)
+	raw := cf.rawFlag()
+	create2 := cf.Bool("create2", false, "deploy deterministically with CREATE2 through the factory (requires --salt)")
+	saltStr := cf.String("salt", "", "salt of the CREATE2 deployment, 32 bytes as hex or any text, which is hashed")
+	factoryHex := cf.String("factory", "", "address of the CREATE2 factory, hex or bech32 (default: factory of the network profile)")
+	managed := cf.Bool("managed", false, "deploy the managed token variant with minter, pauser and admin roles")
+	adminHex := cf.String("admin", "", "address, which is granted all roles of the managed token, hex or bech32 (default: the signer)")
+	dryRunFlag := cf.dryRunFlag()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	settings, err := token.settings(*raw)
+	if err != nil {
+		return err
+	}
+	if !*create2 && (*saltStr != "" || *factoryHex != "") {
+		return usageErrorf("--salt and --factory require --create2")
+	}
+	if *managed && *create2 {
+		return usageErrorf("--managed does not support --create2")
+	}
+	if !*managed && *adminHex != "" {
+		return usageErrorf("--admin requires --managed")
+	}
+	var admin common.Address
+	if *adminHex != "" {
+		if admin, err = parseAddress("admin", *adminHex); err != nil {
+			return err
+		}
+	}
+	var salt common.Hash
+	if *create2 {
+		if *saltStr == "" {
+			return usageErrorf("--create2 requires --salt")
+		}
+		if salt, err = util.ParseSalt(*saltStr); err != nil {
+			return usageErrorf("--salt: %v", err)
+		}
+	}
+	privKey, err := signer.privateKey()
+	if err != nil {
+		return err
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+	waitOptions, err := wait.options(profile)
+	if err != nil {
+		return err
+	}
+	var factoryAddress common.Address
+	if *create2 {
+		if *factoryHex == "" && profile.Factory == "" {
+			return usageErrorf("--create2 requires --factory or a factory in the network profile %q", profile.Name)
+		}
+		if factoryAddress, err = parseAddress("factory", firstNonEmpty(*factoryHex, profile.Factory)); err != nil {
+			return err
+		}
+	}
+
+	// Connect to the node and return the client plus a transaction signer,
+	// that can be used to deploy the contract.
+	client, auth, err := util.GetClientAndTransactionSigner(profile, privKey)
+	if err != nil {
+		return fmt.Errorf("error while connecting to the node and getting the transaction signer: %w", err)
+	}
+	defer client.Close()
+
+	if *managed {
+		return deployManaged(client, auth, profile, waitOptions, settings, admin, *dryRunFlag, *raw)
+	}
+	if *create2 {
+		return deployCreate2(client, auth, profile, waitOptions, settings, factoryAddress, salt, *dryRunFlag, *raw)
+	}
+	if *dryRunFlag {
+		estimate, err := util.EstimateDeployment(auth, client, settings, profile.FeeOptions())
+		if err != nil {
+			return fmt.Errorf("error while estimating the deployment: %w", err)
+		}
+		printHeader("maltcoin deploy", fmt.Sprintf("Estimates the deployment of a Maltcoin token contract to the %q network.", profile.Name))
+		printTokenSettings(settings, auth.From, *raw)
+		return dryRun(auth, estimate, estimateOutput{Network: profile.Name, Contract: *estimate.ContractAddress}, *raw)
+	}
+
+	// Fill the transaction signer fields for the deployment and deploy
+	// the contract
+	contractAddress, tx, _, err := util.DeployContract(auth, client, settings, profile.FeeOptions())
+	if err != nil {
+		return fmt.Errorf("error while deploying the token contract: %w", err)
+	}
+
+	// Print information into terminal output
+	printHeader("maltcoin deploy", fmt.Sprintf("Deploys a Maltcoin token contract to the %q network.", profile.Name))
+	printTokenSettings(settings, auth.From, *raw)
+	fmt.Println("Current nonce: ", auth.Nonce)
+	fmt.Println("Estimated gas:", auth.GasLimit)
+	fmt.Println("Fee mode:", util.DescribeFees(auth))
+	fmt.Println()
+
+	// Wait for the deployment to be confirmed, so that the contract
+	// can be used right away.
+	receipt, err := waitForTransaction(client, tx, waitOptions)
+	if err != nil {
+		return fmt.Errorf("deployment in transaction %s was not successful: %w", tx.Hash().Hex(), err)
+	}
+
+	fmt.Println("\n*********** Success ***********")
+	fmt.Println("The token contract was deployed in transaction ", tx.Hash().Hex())
+	fmt.Println("The contract address is ", contractAddress)
+
+	output := newDeployOutput(profile.Name, contractAddress, settings, auth.From)
+	transaction := newTransactionOutput(tx, auth.From, receipt)
+	output.Transaction = &transaction
+
+	return emit(output)
+}
+
+// printTokenSettings prints the constructor arguments of the deployed token.
+func printTokenSettings(settings util.TokenSettings, deployer common.Address, raw bool) {
+	unit := settings.Unit()
+	unit.Raw = raw
+	fmt.Println("Token name:    ", settings.Name)
+	fmt.Println("Token symbol:  ", settings.Symbol)
+	fmt.Println("Initial supply:", util.NewTokenAmount(settings.InitialSupply, unit))
+	fmt.Println("Initial holder:", settings.Holder(deployer))
+}
+
+// deployManaged deploys the managed token contract with the given settings,
+// whose roles are granted to the admin or, if it is the zero address, to the
+// signer. With a dry run, the deployment is only estimated.
+func deployManaged(client *ethclient.Client, auth *bind.TransactOpts, profile util.NetworkProfile, waitOptions util.WaitOptions, settings util.TokenSettings, admin common.Address, dryRunOnly, raw bool) error {
+	roleAdmin := admin
+	if roleAdmin == (common.Address{}) {
+		roleAdmin = auth.From
+	}
+
+	if dryRunOnly {
+		estimate, err := util.EstimateManagedDeployment(auth, client, settings, admin, profile.FeeOptions())
+		if err != nil {
+			return fmt.Errorf("error while estimating the deployment: %w", err)
+		}
+		printHeader("maltcoin deploy", fmt.Sprintf("Estimates the deployment of a managed Maltcoin token contract to the %q network.", profile.Name))
+		printTokenSettings(settings, auth.From, raw)
+		fmt.Println("Role admin:    ", roleAdmin)
+		return dryRun(auth, estimate, estimateOutput{Network: profile.Name, Contract: *estimate.ContractAddress}, raw)
+	}
+
+	contractAddress, tx, _, err := util.DeployManagedContract(auth, client, settings, admin, profile.FeeOptions())
+	if err != nil {
+		return fmt.Errorf("error while deploying the token contract: %w", err)
+	}
+
+	printHeader("maltcoin deploy", fmt.Sprintf("Deploys a managed Maltcoin token contract to the %q network.", profile.Name))
+	printTokenSettings(settings, auth.From, raw)
+	fmt.Println("Role admin:    ", roleAdmin)
+	fmt.Println("Current nonce: ", auth.Nonce)
+	fmt.Println("Estimated gas:", auth.GasLimit)
+	fmt.Println("Fee mode:", util.DescribeFees(auth))
+	fmt.Println()
+
+	managedABI, err := util.GetManagedABI()
+	if err != nil {
+		return err
+	}
+	receipt, err := waitForTransaction(client, tx, waitOptions, managedABI)
+	if err != nil {
+		return fmt.Errorf("deployment in transaction %s was not successful: %w", tx.Hash().Hex(), err)
+	}
+
+	fmt.Println("\n*********** Success ***********")
+	fmt.Println("The token contract was deployed in transaction ", tx.Hash().Hex())
+	fmt.Println("The contract address is ", contractAddress)
+
+	output := newDeployOutput(profile.Name, contractAddress, settings, auth.From)
+	output.Admin = &roleAdmin
+	transaction := newTransactionOutput(tx, auth.From, receipt)
+	output.Transaction = &transaction
+
+	return emit(output)
+}
+
+// deployCreate2 deploys the token contract with the given settings through
+// the factory with the given salt, unless it already exists at the computed
+// address. With a dry run, the deployment is only estimated. Without an
+// initial holder, the signer is made the holder, because the token would
+// mint the initial supply to its sender, i.e. to the factory.
+func deployCreate2(client *ethclient.Client, auth *bind.TransactOpts, profile util.NetworkProfile, waitOptions util.WaitOptions, settings util.TokenSettings, factoryAddress common.Address, salt common.Hash, dryRunOnly, raw bool) error {
+	// The holder is part of the init code, so that whoever sends the
+	// deployment, the initial supply is assigned to the holder
+	settings.InitialHolder = settings.Holder(auth.From)
+	initCode, err := util.GetDeployData(settings.Args()...)
+	if err != nil {
+		return err
+	}
+
+	description := "Deploys a Maltcoin token contract with CREATE2 to the %q network."
+	if dryRunOnly {
+		description = "Estimates the deployment of a Maltcoin token contract with CREATE2 to the %q network."
+	}
+	printHeader("maltcoin deploy", fmt.Sprintf(description, profile.Name))
+	printTokenSettings(settings, auth.From, raw)
+	fmt.Println("Factory:         ", factoryAddress)
+	fmt.Println("Salt:            ", salt.Hex())
+	fmt.Println("Init code hash:  ", crypto.Keccak256Hash(initCode).Hex())
+	fmt.Println("Computed address:", util.Create2Address(factoryAddress, salt, initCode))
+	fmt.Println()
+
+	// A dry run only prepares the deployment, so that it can be estimated
+	ctx := context.Background()
+	deploy := util.DeployCreate2
+	if dryRunOnly {
+		deploy = util.PrepareCreate2
+	}
+	deployment, err := deploy(ctx, auth, client, factoryAddress, salt, initCode, profile.FeeOptions())
+	if err != nil {
+		return fmt.Errorf("error while deploying the token contract: %w", err)
+	}
+	create2 := &create2Output{
+		Factory:      deployment.Factory,
+		Salt:         deployment.Salt,
+		InitCodeHash: deployment.InitCodeHash,
+		Existing:     deployment.Existing,
+	}
+	output := newDeployOutput(profile.Name, deployment.Address, settings, auth.From)
+	output.Create2 = create2
+	if deployment.Existing {
+		fmt.Println("The token contract already exists at ", deployment.Address)
+		fmt.Println("The deployment is skipped.")
+		return emit(output)
+	}
+	if dryRunOnly {
+		estimate, err := util.EstimateTransaction(ctx, client, auth)
+		if err != nil {
+			return err
+		}
+		return dryRun(auth, estimate, estimateOutput{
+			Network:  profile.Name,
+			To:       &factoryAddress,
+			Contract: deployment.Address,
+			Create2:  create2,
+		}, raw)
+	}
+
+	tx := deployment.Transaction
+	fmt.Println("Current nonce: ", auth.Nonce)
+	fmt.Println("Estimated gas:", auth.GasLimit)
+	fmt.Println("Fee mode:", util.DescribeFees(auth))
+	fmt.Println()
+
+	receipt, err := waitForTransaction(client, tx, waitOptions, factoryABIs()...)
+	if err != nil {
+		return fmt.Errorf("deployment in transaction %s was not successful: %w", tx.Hash().Hex(), err)
+	}
+	transaction := newTransactionOutput(tx, auth.From, receipt)
+	output.Transaction = &transaction
+
+	// Check, that the factory deployed the contract at the computed address
+	if err := util.VerifyCreate2Deployment(ctx, client, deployment, receipt); err != nil {
+		return err
+	}
+
+	fmt.Println("\n*********** Success ***********")
+	fmt.Println("The token contract was deployed in transaction ", tx.Hash().Hex())
+	fmt.Println("The contract address is ", deployment.Address)
+
+	return emit(output)
+}
+
+// factoryABIs returns the ABIs to decode the failure reason of a deployment
+// through the factory.
+func factoryABIs() []*abi.ABI {
+	var abis []*abi.ABI
+	if factoryABI, err := util.GetFactoryABI(); err == nil {
+		abis = append(abis, factoryABI)
+	}
+	if maltcoinABI, err := util.GetMaltcoinABI(); err == nil {
+		abis = append(abis, maltcoinABI)
+	}
+
+	return abis
+}
+
+// runDeployFactory deploys the factory for deterministic deployments.
+func runDeployFactory(args []string) error {
+	cf := newCommandFlags("deploy-factory", "Deploy the factory, which deploys contracts with CREATE2 at deterministic addresses. "+
+		"The factory address depends on the signer and its nonce, so that deploying it from the same fresh account "+
+		"results in the same address on every network.")
+	signer := cf.signerFlags()
+	wait := cf.waitFlags()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	privKey, err := signer.privateKey()
+	if err != nil {
+		return err
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+	waitOptions, err := wait.options(profile)
+	if err != nil {
+		return err
+	}
+
+	client, auth, err := util.GetClientAndTransactionSigner(profile, privKey)
+	if err != nil {
+		return fmt.Errorf("error while connecting to the node and getting the transaction signer: %w", err)
+	}
+	defer client.Close()
+
+	factoryAddress, tx, err := util.DeployFactory(auth, client, profile.FeeOptions())
+	if err != nil {
+		return fmt.Errorf("error while deploying the factory: %w", err)
+	}
+
+	printHeader("maltcoin deploy-factory", fmt.Sprintf("Deploys the CREATE2 factory to the %q network.", profile.Name))
+	fmt.Println("Current nonce: ", auth.Nonce)
+	fmt.Println("Estimated gas:", auth.GasLimit)
+	fmt.Println("Fee mode:", util.DescribeFees(auth))
+	fmt.Println()
+
+	receipt, err := waitForTransaction(client, tx, waitOptions, factoryABIs()...)
+	if err != nil {
+		return fmt.Errorf("deployment in transaction %s was not successful: %w", tx.Hash().Hex(), err)
+	}
+
+	fmt.Println("\n*********** Success ***********")
+	fmt.Println("The factory was deployed in transaction ", tx.Hash().Hex())
+	fmt.Println("The factory address is ", factoryAddress)
+	fmt.Printf("Add \"factory\": %q to the %q network profile to use it with deploy --create2.\n", factoryAddress.Hex(), profile.Name)
+
+	return emit(factoryOutput{
+		Network:     profile.Name,
+		Factory:     factoryAddress,
+		Transaction: newTransactionOutput(tx, auth.From, receipt),
+	})
+}
@@ -0,0 +1,85 @@
+// dryrun.go contains the output of dry runs, which prepare and estimate a
+// transaction without sending it.
+package main
+
+import (
+	"fmt"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// estimateOutput is the structured output of a dry run. The contract is the
+// predicted address of a deployment or the token contract of a transfer.
+type estimateOutput struct {
+	DryRun     bool            `json:"dry_run"`
+	Network    string          `json:"network"`
+	From       common.Address  `json:"from"`
+	To         *common.Address `json:"to"`
+	Contract   common.Address  `json:"contract"`
+	Nonce      uint64          `json:"nonce"`
+	GasLimit   uint64          `json:"gas_limit"`
+	Fees       feesOutput      `json:"fees"`
+	Cost       amountOutput    `json:"cost"`
+	Balance    amountOutput    `json:"balance"`
+	Sufficient bool            `json:"sufficient"`
+	Create2    *create2Output  `json:"create2,omitempty"`
+}
+
+// newFeesOutput returns the fees, which are set on the given transaction
+// signer.
+func newFeesOutput(auth *bind.TransactOpts) feesOutput {
+	if auth.GasFeeCap != nil {
+		return feesOutput{
+			Mode:                 "dynamic",
+			MaxFeePerGas:         auth.GasFeeCap.String(),
+			MaxPriorityFeePerGas: auth.GasTipCap.String(),
+		}
+	}
+
+	return feesOutput{Mode: "legacy", GasPrice: auth.GasPrice.String()}
+}
+
+// dryRun prints and emits the given estimate of the transaction, for which
+// the given transaction signer was filled, instead of sending it. The
+// address of the created or called contract is given as contract and, for
+// calls, also as recipient of the transaction. ErrInsufficientFunds is
+// returned, if the sender can't pay for the transaction.
+func dryRun(auth *bind.TransactOpts, estimate *util.TransactionEstimate, output estimateOutput, raw bool) error {
+	unit := util.NativeUnit
+	unit.Raw = raw
+
+	output.DryRun = true
+	output.From = estimate.From
+	output.Nonce = estimate.Nonce
+	output.GasLimit = estimate.GasLimit
+	output.Fees = newFeesOutput(auth)
+	output.Cost = newAmountOutput(estimate.Cost, unit)
+	output.Balance = newAmountOutput(estimate.Balance, unit)
+	output.Sufficient = estimate.Sufficient()
+
+	fmt.Println("Dry run, the transaction is not sent.")
+	fmt.Println()
+	fmt.Println("Sender:          ", estimate.From)
+	if output.To != nil {
+		fmt.Println("Recipient:       ", *output.To)
+	}
+	fmt.Println("Contract address:", output.Contract)
+	fmt.Println("Nonce:           ", estimate.Nonce)
+	fmt.Println("Estimated gas:   ", estimate.GasLimit)
+	fmt.Println("Fee mode:        ", util.DescribeFees(auth))
+	fmt.Println("Maximum cost:    ", output.Cost)
+	fmt.Println("Balance:         ", output.Balance)
+	if output.Sufficient {
+		fmt.Println("The balance covers the maximum cost of the transaction.")
+	} else {
+		fmt.Println("The balance does NOT cover the maximum cost of the transaction.")
+	}
+
+	if err := emit(output); err != nil {
+		return err
+	}
+
+	return estimate.Err()
+}
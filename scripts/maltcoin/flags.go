@@ -0,0 +1,424 @@
+// flags.go contains the flag handling, that is shared between the
+// subcommands, as well as the validation of the flag values.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/address"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// usageError is returned by the subcommands, if the given flags or
+// arguments are invalid.
+type usageError struct {
+	err error
+	// printed is true, if the flag package already printed the error.
+	printed bool
+}
+
+func (e *usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e *usageError) Unwrap() error {
+	return e.err
+}
+
+// usageErrorf creates a usage error with the given formatted message.
+func usageErrorf(format string, args ...interface{}) error {
+	return &usageError{err: fmt.Errorf(format, args...)}
+}
+
+// stringList is a flag value, which collects the values of a flag,
+// that can be given multiple times.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// commandFlags contains the flag set of a subcommand together with
+// the network and output flags, that every subcommand accepts.
+type commandFlags struct {
+	*flag.FlagSet
+
+	network *util.NetworkFlags
+	output  *string
+}
+
+// newCommandFlags creates the flag set for the subcommand with the given
+// name and description. The network flags are registered automatically.
+func newCommandFlags(name, description string) *commandFlags {
+	cf := newOfflineCommandFlags(name, description)
+	cf.network = util.AddNetworkFlags(cf.FlagSet)
+
+	return cf
+}
+
+// newOfflineCommandFlags creates the flag set for a subcommand, which does
+// not connect to a node, so that no network flags are registered. The
+// output flag is registered automatically.
+func newOfflineCommandFlags(name, description string) *commandFlags {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		w := fs.Output()
+		fmt.Fprintf(w, "%s\n\nUsage:\n\n  maltcoin %s [flags]\n\nFlags:\n\n", description, name)
+		fs.PrintDefaults()
+	}
+
+	return &commandFlags{FlagSet: fs, output: outputFlag(fs)}
+}
+
+// parse parses the given arguments and selects the output format.
+// Positional arguments are rejected, because all input is given with named
+// flags.
+func (cf *commandFlags) parse(args []string) error {
+	err := cf.Parse(args)
+	// The output format is selected even if a later flag is invalid, so that
+	// the error is reported in the requested format.
+	if outputErr := selectOutput(*cf.output); outputErr != nil && err == nil {
+		return outputErr
+	}
+	if err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return &usageError{err: err, printed: true}
+	}
+
+	if cf.NArg() > 0 {
+		return usageErrorf("unexpected arguments: %s", strings.Join(cf.Args(), " "))
+	}
+
+	return nil
+}
+
+// isSet reports, if the flag with the given name was given on the command
+// line.
+func (cf *commandFlags) isSet(name string) bool {
+	set := false
+	cf.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
+
+// profile returns the network profile, that was selected with the
+// network flags.
+func (cf *commandFlags) profile() (util.NetworkProfile, error) {
+	profile, err := cf.network.Profile()
+	if err != nil {
+		return util.NetworkProfile{}, &usageError{err: err}
+	}
+
+	return profile, nil
+}
+
+// addressFlag registers a required address flag with the given name.
+// Hex and bech32 addresses are accepted.
+func (cf *commandFlags) addressFlag(name, usage string) *string {
+	return cf.String(name, "", usage+", hex or bech32 (required)")
+}
+
+// waitFlags contains the flags, which configure how long and how often
+// the receipt of a sent transaction is polled.
+type waitFlags struct {
+	timeout      *time.Duration
+	pollInterval *time.Duration
+}
+
+// waitFlags registers the flags to configure waiting for transactions.
+// The number of confirmations is taken from the network profile.
+func (cf *commandFlags) waitFlags() *waitFlags {
+	defaults := util.DefaultWaitOptions()
+
+	return &waitFlags{
+		timeout:      cf.Duration("timeout", defaults.Timeout, "maximum time to wait for the transaction to be confirmed"),
+		pollInterval: cf.Duration("poll-interval", defaults.PollInterval, "time between two queries of the transaction receipt"),
+	}
+}
+
+// options returns the wait options for the given network profile.
+func (wf *waitFlags) options(profile util.NetworkProfile) (util.WaitOptions, error) {
+	if *wf.timeout <= 0 {
+		return util.WaitOptions{}, usageErrorf("--timeout must be positive")
+	}
+	if *wf.pollInterval <= 0 {
+		return util.WaitOptions{}, usageErrorf("--poll-interval must be positive")
+	}
+
+	return util.WaitOptions{
+		Timeout:       *wf.timeout,
+		PollInterval:  *wf.pollInterval,
+		Confirmations: profile.Confirmations,
+	}, nil
+}
+
+// Sources of the loaded artifact
+const (
+	artifactBindings = "bindings"
+	artifactFiles    = "files"
+	artifactCompiled = "compiled"
+)
+
+// artifactFlags contains the flags, which select the build artifact of the
+// contract. Without them, the contract of the Go bindings is used.
+type artifactFlags struct {
+	abiPath *string
+	binPath *string
+	compile *bool
+	solc    *string
+	source  *string
+}
+
+// artifactFlags registers the flags to load the artifact, that the command
+// uses for the given purpose, e.g. "deploy".
+func (cf *commandFlags) artifactFlags(purpose string) *artifactFlags {
+	return &artifactFlags{
+		abiPath: cf.String("abi", "", fmt.Sprintf("ABI file written by solc to %s instead of the compiled-in contract (requires --bin)", purpose)),
+		binPath: cf.String("bin", "", fmt.Sprintf("bytecode file written by solc to %s instead of the compiled-in contract (requires --abi)", purpose)),
+		compile: cf.Bool("compile", false, "compile the contract source with solc instead of using the compiled-in contract"),
+		solc:    cf.String("solc", "solc", "path of the solc binary"),
+		source:  cf.String("source", "contracts/Maltcoin.sol", "Solidity source of the contract to compile"),
+	}
+}
+
+// validate checks, that the artifact is selected by only one source.
+func (af *artifactFlags) validate() error {
+	if (*af.abiPath == "") != (*af.binPath == "") {
+		return usageErrorf("--abi and --bin have to be given together")
+	}
+	if *af.compile && *af.abiPath != "" {
+		return usageErrorf("--compile and --abi are mutually exclusive")
+	}
+
+	return nil
+}
+
+// kind returns the source of the selected artifact.
+func (af *artifactFlags) kind() string {
+	switch {
+	case *af.compile:
+		return artifactCompiled
+	case *af.abiPath != "":
+		return artifactFiles
+	default:
+		return artifactBindings
+	}
+}
+
+// load loads the selected artifact of the Maltcoin contract.
+func (af *artifactFlags) load(ctx context.Context) (*util.Artifact, error) {
+	switch af.kind() {
+	case artifactCompiled:
+		return util.CompileArtifact(ctx, *af.solc, *af.source, "Maltcoin")
+	case artifactFiles:
+		return util.LoadArtifact("Maltcoin", *af.abiPath, *af.binPath)
+	default:
+		return util.MaltcoinArtifact()
+	}
+}
+
+// tokenFlags contains the flags, which set the constructor arguments of a
+// deployed token contract.
+type tokenFlags struct {
+	name   *string
+	symbol *string
+	supply *string
+	// holder is nil, if the initial supply is always minted to the deployer.
+	holder *string
+}
+
+// tokenFlags registers the flags to set the name, symbol and initial supply
+// of a deployed token contract and, if withHolder is set, the account, which
+// receives the initial supply.
+func (cf *commandFlags) tokenFlags(withHolder bool) *tokenFlags {
+	defaults := util.DefaultTokenSettings()
+	tf := &tokenFlags{
+		name:   cf.String("name", defaults.Name, "name of the token"),
+		symbol: cf.String("symbol", defaults.Symbol, "symbol of the token"),
+		supply: cf.String("supply", util.NewTokenAmount(defaults.InitialSupply, defaults.Unit()).Plain(), "initial supply of the token, which is minted upon deployment"),
+	}
+	if withHolder {
+		tf.holder = cf.String("holder", "", "account, which receives the initial supply, hex or bech32 (default: the signer)")
+	}
+
+	return tf
+}
+
+// settings validates the flags and returns the token settings. The initial
+// supply is given in whole tokens with 18 decimals or, if raw is set, in the
+// smallest unit.
+func (tf *tokenFlags) settings(raw bool) (util.TokenSettings, error) {
+	settings := util.TokenSettings{Name: *tf.name, Symbol: *tf.symbol}
+	unit := settings.Unit()
+	unit.Raw = raw
+	supply, err := util.ParseTokenAmount(*tf.supply, unit)
+	if err != nil {
+		return util.TokenSettings{}, usageErrorf("--supply: %v", err)
+	}
+	settings.InitialSupply = supply.Value
+	if tf.holder != nil && *tf.holder != "" {
+		if settings.InitialHolder, err = parseAddress("holder", *tf.holder); err != nil {
+			return util.TokenSettings{}, err
+		}
+	}
+	if err := settings.Validate(); err != nil {
+		return util.TokenSettings{}, &usageError{err: err}
+	}
+
+	return settings, nil
+}
+
+// transactionBackend defines the methods, which are needed to wait for a
+// transaction and to diagnose it, if it failed.
+type transactionBackend interface {
+	util.WaitBackend
+	util.DiagnoseBackend
+}
+
+// waitForTransaction waits for the confirmation of the given transaction
+// and prints the progress. If the transaction failed, the reason is
+// determined using the given ABIs or, if none are given, the Maltcoin ABI.
+func waitForTransaction(backend transactionBackend, tx *types.Transaction, opts util.WaitOptions, abis ...*abi.ABI) (*types.Receipt, error) {
+	fmt.Printf("Waiting for %d confirmation(s) of transaction %s ..\n", opts.Confirmations, tx.Hash().Hex())
+
+	receipt, err := util.WaitForTransaction(context.Background(), backend, tx.Hash(), opts)
+	if errors.Is(err, util.ErrTransactionFailed) {
+		if len(abis) == 0 {
+			if maltcoinABI, abiErr := util.GetMaltcoinABI(); abiErr == nil {
+				abis = append(abis, maltcoinABI)
+			}
+		}
+		if diagnosed := util.DiagnoseTransaction(context.Background(), backend, tx.Hash(), abis...); errors.Is(diagnosed, util.ErrTransactionFailed) {
+			err = diagnosed
+		}
+	}
+	if err != nil {
+		return receipt, err
+	}
+	fmt.Printf("Transaction confirmed in block %v (gas used: %d).\n", receipt.BlockNumber, receipt.GasUsed)
+
+	return receipt, nil
+}
+
+// parseAddress validates and converts the value of the address flag
+// with the given name, which is either a hex or a bech32 address.
+func parseAddress(name, value string) (common.Address, error) {
+	if value == "" {
+		return common.Address{}, usageErrorf("--%s is required", name)
+	}
+
+	parsed, err := address.Parse(value, bech32Prefix())
+	if err != nil {
+		return common.Address{}, usageErrorf("--%s: %v", name, err)
+	}
+
+	return parsed, nil
+}
+
+// bech32Prefix returns the human-readable part of bech32 addresses from
+// the environment or the default.
+func bech32Prefix() string {
+	return firstNonEmpty(os.Getenv(address.EnvHRP), address.DefaultHRP)
+}
+
+// rawFlag registers the flag, which switches the token amounts of a
+// subcommand from whole tokens to the smallest unit of the token.
+func (cf *commandFlags) rawFlag() *bool {
+	return cf.Bool("raw", false, "give and show token amounts in the smallest unit instead of whole tokens")
+}
+
+// dryRunFlag registers the flag to estimate a transaction without sending
+// it.
+func (cf *commandFlags) dryRunFlag() *bool {
+	return cf.Bool("dry-run", false, "only print the estimated gas and cost and check the native balance, without sending the transaction")
+}
+
+// requireAmount checks, that the amount flag with the given name is set.
+// The amount is parsed with parseAmount, once the unit of the token is
+// known.
+func requireAmount(name, value string) error {
+	if value == "" {
+		return usageErrorf("--%s is required", name)
+	}
+
+	return nil
+}
+
+// parseAmount validates and converts the value of the amount flag with
+// the given name. The amount is given in the given unit of the token, e.g.
+// "1.5" or "1.5MALT" in whole tokens, and must be positive.
+func parseAmount(name, value string, unit util.TokenUnit) (*big.Int, error) {
+	if err := requireAmount(name, value); err != nil {
+		return nil, err
+	}
+
+	amount, err := util.ParseTokenAmount(value, unit)
+	if err != nil {
+		return nil, usageErrorf("--%s: %v", name, err)
+	}
+	if amount.Value.Sign() <= 0 {
+		return nil, usageErrorf("--%s: invalid amount %q, must be positive", name, value)
+	}
+
+	return amount.Value, nil
+}
+
+// tokenUnit queries the unit of the token contract. Amounts are given in
+// whole tokens or, if raw is set, in the smallest unit.
+func tokenUnit(caller bind.ContractCaller, contractAddress common.Address, raw bool) (util.TokenUnit, error) {
+	unit, err := util.GetTokenUnit(&bind.CallOpts{Context: context.Background()}, caller, contractAddress)
+	if err != nil {
+		return util.TokenUnit{}, err
+	}
+	unit.Raw = raw
+
+	return unit, nil
+}
+
+// parseTxHash validates and converts the given transaction hash.
+func parseTxHash(name, value string) (common.Hash, error) {
+	if value == "" {
+		return common.Hash{}, usageErrorf("--%s is required", name)
+	}
+
+	bz, err := hexBytes(value)
+	if err != nil || len(bz) != common.HashLength {
+		return common.Hash{}, usageErrorf("--%s: invalid transaction hash %q", name, value)
+	}
+
+	return common.BytesToHash(bz), nil
+}
+
+// hexBytes decodes a hex string with or without 0x prefix.
+func hexBytes(value string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(value, "0x"), "0X"))
+}
+
+// printHeader prints the title and description of a subcommand output.
+func printHeader(title, description string) {
+	fmt.Printf("\n%s\n-----------------------------------------------------\n", title)
+	fmt.Printf("%s\n\n", description)
+}
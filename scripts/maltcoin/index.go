@@ -0,0 +1,277 @@
+// index.go contains the index subcommand, which stores the events of a token
+// contract in a local database, and the events subcommand, which queries it.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// indexOutput is the structured output of the index subcommand.
+type indexOutput struct {
+	Contract   common.Address `json:"contract"`
+	Database   string         `json:"database"`
+	StartBlock uint64         `json:"start_block"`
+	IndexedTo  *uint64        `json:"indexed_to"`
+}
+
+// eventsOutput is the structured output of the events subcommand. IndexedTo
+// is null, if no block was indexed yet.
+type eventsOutput struct {
+	Contract  common.Address `json:"contract"`
+	IndexedTo *uint64        `json:"indexed_to"`
+	Events    []eventOutput  `json:"events"`
+}
+
+// eventOutput is an indexed event with the amount in the unit of the token.
+type eventOutput struct {
+	Type        string         `json:"type"`
+	BlockNumber uint64         `json:"block_number"`
+	BlockHash   common.Hash    `json:"block_hash"`
+	TxHash      common.Hash    `json:"tx_hash"`
+	TxIndex     uint           `json:"tx_index"`
+	LogIndex    uint           `json:"log_index"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Amount      amountOutput   `json:"amount"`
+}
+
+// indexedTo returns the progress of the event store or nil, if no block
+// was indexed yet.
+func indexedTo(store *util.EventStore) (*uint64, error) {
+	progress, ok, err := store.Progress()
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &progress, nil
+}
+
+// runIndex indexes the Transfer and Approval events of the token contract
+// from the deployment block. The progress is stored in the database, so
+// that the command resumes, where it stopped.
+func runIndex(args []string) error {
+	cf := newCommandFlags("index", "Store the Transfer and Approval events of a token contract in a local database. "+
+		"The indexer resumes after the last indexed block, when it is run again.")
+	contractHex := cf.addressFlag("contract", "address of the token contract")
+	dbDir := cf.String("db", util.DefaultEventStoreDir, "directory of the event database")
+	fromBlock := cf.Uint64("from-block", 0, "first block to index, if the database is empty")
+	deployTxHex := cf.String("deploy-tx", "", "hash of the deployment transaction to start indexing at its block, if the database is empty")
+	batchSize := cf.Uint64("batch-size", util.DefaultIndexerOptions().BatchSize, "maximum number of blocks to query at once")
+	live := cf.Bool("live", false, "keep indexing new events after catching up (requires a websocket URL)")
+	pollInterval := cf.Duration("poll-interval", util.DefaultIndexerOptions().PollInterval, "time between two catch-ups in live mode")
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	if *batchSize == 0 {
+		return usageErrorf("--batch-size must be positive")
+	}
+	if *pollInterval <= 0 {
+		return usageErrorf("--poll-interval must be positive")
+	}
+	var deployTx common.Hash
+	if *deployTxHex != "" {
+		if cf.isSet("from-block") {
+			return usageErrorf("--from-block and --deploy-tx are mutually exclusive")
+		}
+		if deployTx, err = parseTxHash("deploy-tx", *deployTxHex); err != nil {
+			return err
+		}
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	store, err := util.OpenEventStore(*dbDir, contractAddress)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	client, err := util.GetClient(profile)
+	if err != nil {
+		return fmt.Errorf("failed to connect to node: %w", err)
+	}
+	defer client.Close()
+
+	startBlock := *fromBlock
+	if *deployTxHex != "" {
+		if startBlock, err = deploymentBlock(client, deployTx, contractAddress); err != nil {
+			return err
+		}
+	}
+
+	// The unit is stored, so that the events command can show the amounts
+	// in whole tokens without a connection to the node
+	unit, err := tokenUnit(client, contractAddress, false)
+	if err != nil {
+		return err
+	}
+	if err := store.SetTokenUnit(unit); err != nil {
+		return err
+	}
+
+	printHeader("maltcoin index", fmt.Sprintf("Indexes the events of the token contract on the %q network.", profile.Name))
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Database:         ", *dbDir)
+	if progress, ok, err := store.Progress(); err != nil {
+		return err
+	} else if ok {
+		fmt.Println("Resuming after:   ", progress)
+	} else {
+		fmt.Println("Start block:      ", startBlock)
+	}
+	fmt.Println()
+
+	indexer, err := util.NewIndexer(client, store, util.IndexerOptions{
+		StartBlock:    startBlock,
+		BatchSize:     *batchSize,
+		Confirmations: profile.Confirmations,
+		Live:          *live,
+		PollInterval:  *pollInterval,
+		OnProgress: func(block, target uint64) {
+			fmt.Printf("Indexed blocks up to %d of %d\n", block, target)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Interrupting the indexer is safe, because the progress is only stored
+	// together with the events of a batch.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := indexer.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	progress, err := indexedTo(store)
+	if err != nil {
+		return err
+	}
+
+	return emit(indexOutput{Contract: contractAddress, Database: *dbDir, StartBlock: startBlock, IndexedTo: progress})
+}
+
+// deploymentBlock returns the block of the given deployment transaction of
+// the contract.
+func deploymentBlock(client util.ReceiptBackend, deployTx common.Hash, contractAddress common.Address) (uint64, error) {
+	receipt, err := client.TransactionReceipt(context.Background(), deployTx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve receipt of deployment: %w", err)
+	}
+	if receipt.ContractAddress != contractAddress {
+		return 0, fmt.Errorf("transaction %s deployed %s instead of %s", deployTx.Hex(), receipt.ContractAddress, contractAddress)
+	}
+
+	return receipt.BlockNumber.Uint64(), nil
+}
+
+// runEvents prints the indexed events of the token contract, which match
+// the given address, transaction or range of blocks.
+func runEvents(args []string) error {
+	cf := newOfflineCommandFlags("events", "Print the events stored by the index command. "+
+		"Events can be selected by address, transaction or range of blocks.")
+	contractHex := cf.addressFlag("contract", "address of the token contract")
+	dbDir := cf.String("db", util.DefaultEventStoreDir, "directory of the event database")
+	addressValue := cf.String("address", "", "print the events, in which the address is sender, recipient, owner or spender, hex or bech32")
+	txHashHex := cf.String("tx", "", "print the events emitted by the transaction")
+	fromBlock := cf.Uint64("from-block", 0, "first block of the printed events")
+	toBlock := cf.Uint64("to-block", math.MaxUint64, "last block of the printed events")
+	raw := cf.rawFlag()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	if *addressValue != "" && *txHashHex != "" {
+		return usageErrorf("--address and --tx are mutually exclusive")
+	}
+	if *fromBlock > *toBlock {
+		return usageErrorf("--from-block must not be greater than --to-block")
+	}
+
+	store, err := util.OpenEventStoreReadOnly(*dbDir, contractAddress)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	var events []util.IndexedEvent
+	switch {
+	case *addressValue != "":
+		filter, err := parseAddress("address", *addressValue)
+		if err != nil {
+			return err
+		}
+		if events, err = store.EventsByAddress(filter); err != nil {
+			return err
+		}
+	case *txHashHex != "":
+		txHash, err := parseTxHash("tx", *txHashHex)
+		if err != nil {
+			return err
+		}
+		if events, err = store.EventsByTx(txHash); err != nil {
+			return err
+		}
+	default:
+		if events, err = store.EventsInBlocks(*fromBlock, *toBlock); err != nil {
+			return err
+		}
+	}
+
+	progress, err := indexedTo(store)
+	if err != nil {
+		return err
+	}
+	if progress != nil {
+		fmt.Printf("Indexed up to block %d.\n", *progress)
+	} else {
+		fmt.Println("No blocks were indexed yet.")
+	}
+	// Stores without a unit only contain the raw amounts
+	unit, ok, err := store.TokenUnit()
+	if err != nil {
+		return err
+	}
+	unit.Raw = *raw || !ok
+
+	output := eventsOutput{Contract: contractAddress, IndexedTo: progress, Events: make([]eventOutput, 0, len(events))}
+	for _, event := range events {
+		if event.BlockNumber < *fromBlock || event.BlockNumber > *toBlock {
+			continue
+		}
+		fmt.Printf("%-8d %s #%-3d %-8s %s -> %s %v\n", event.BlockNumber, event.TxHash.Hex(), event.LogIndex, event.Type, event.From, event.To, util.NewTokenAmount(event.Value, unit))
+		output.Events = append(output.Events, eventOutput{
+			Type:        event.Type,
+			BlockNumber: event.BlockNumber,
+			BlockHash:   event.BlockHash,
+			TxHash:      event.TxHash,
+			TxIndex:     event.TxIndex,
+			LogIndex:    event.LogIndex,
+			From:        event.From,
+			To:          event.To,
+			Amount:      newAmountOutput(event.Value, unit),
+		})
+	}
+
+	return emit(output)
+}
@@ -0,0 +1,135 @@
+// maltcoin is the command line interface to deploy and interact with
+// Maltcoin ERC20 token contracts. It bundles all interactions with the
+// token contract as subcommands, which use the functions of the util
+// package.
+//
+// Usage:
+//
+//	$ go run github.com/MalteHerrmann/GoSmartContract/scripts/maltcoin <command> [flags]
+//
+// Run a command with --help to print its flags.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Exit codes of the command line interface
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// command defines a subcommand of the command line interface.
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+// commands contains all available subcommands in the order, in which they
+// are printed in the usage information.
+var commands = []command{
+	{"deploy", "Deploy a new Maltcoin token contract", runDeploy},
+	{"deploy-factory", "Deploy the factory for deterministic CREATE2 deployments", runDeployFactory},
+	{"bootstrap", "Deploy a token contract and check it with a transfer", runBootstrap},
+	{"verify", "Verify, that the code at an address matches the build artifacts", runVerify},
+	{"receipt", "Print the receipt of a transaction", runReceipt},
+	{"info", "Print the name, symbol, decimals and total supply of the token", runInfo},
+	{"balance", "Print the token balance of an address", runBalance},
+	{"allowance", "Print the amount a spender may transfer on behalf of an owner", runAllowance},
+	{"transfer", "Transfer tokens to a recipient", runTransfer},
+	{"approve", "Approve a spender to transfer tokens on behalf of the signer", runApprove},
+	{"transfer-from", "Transfer tokens on behalf of an owner, who approved the signer", runTransferFrom},
+	{"sign-permit", "Sign a permit, which approves a spender without a transaction", runSignPermit},
+	{"relay-permit", "Submit a signed permit and transfer the approved tokens", runRelayPermit},
+	{"mint", "Mint tokens of a managed token contract to a recipient", runMint},
+	{"burn", "Burn tokens of the signer or of an owner, who approved the signer", runBurn},
+	{"pause", "Pause all transfers of a managed token contract", runPause},
+	{"unpause", "Resume the transfers of a paused token contract", runUnpause},
+	{"grant-role", "Grant a role of a managed token contract to an account", runGrantRole},
+	{"revoke-role", "Revoke a role of a managed token contract from an account", runRevokeRole},
+	{"roles", "Print the accounts, which have the roles of a managed token contract", runRoles},
+	{"batch-transfer", "Transfer tokens to all recipients in a CSV file", runBatchTransfer},
+	{"index", "Store the events of the token in a local database", runIndex},
+	{"events", "Print the indexed events of an address, transaction or blocks", runEvents},
+	{"snapshot", "Export the balances of all holders at a block to CSV or JSON", runSnapshot},
+	{"call", "Call any method of the token ABI or a given ABI file", runCall},
+	{"address", "Convert an address between the hex and bech32 form", runAddress},
+	{"account-create", "Create a new account in the keystore", runAccountCreate},
+	{"account-import", "Import a private key or key file into the keystore", runAccountImport},
+	{"account-mnemonic", "Print a new random mnemonic to derive accounts from", runAccountMnemonic},
+	{"account-derive", "Derive accounts from a mnemonic and import them into the keystore", runAccountDerive},
+	{"account-export", "Export the key of a keystore account", runAccountExport},
+	{"account-list", "List the accounts in the keystore", runAccountList},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run executes the subcommand given in the arguments and returns
+// the exit code.
+func run(args []string) int {
+	if len(args) == 0 {
+		printUsage(os.Stderr)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		printUsage(os.Stdout)
+		return exitOK
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+
+		defer restoreOutput()
+
+		exitCode := exitOK
+		err := cmd.run(args[1:])
+		var usageErr *usageError
+		switch {
+		case err == nil, errors.Is(err, flag.ErrHelp):
+			return exitOK
+		case errors.As(err, &usageErr):
+			if !usageErr.printed {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", usageErr.err)
+			}
+			fmt.Fprintf(os.Stderr, "Run 'maltcoin %s --help' for usage.\n", cmd.name)
+			exitCode = exitUsage
+		default:
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			exitCode = exitError
+		}
+		// With a structured output format, the error is also written to
+		// stdout, so that scripts can read its code.
+		if structuredOutput() {
+			emitError(err, exitCode)
+		}
+
+		return exitCode
+	}
+
+	fmt.Fprintf(os.Stderr, "Error: unknown command %q\n\n", args[0])
+	printUsage(os.Stderr)
+	return exitUsage
+}
+
+// printUsage prints the available subcommands to the given writer.
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "maltcoin deploys and interacts with Maltcoin ERC20 token contracts.\n\n")
+	fmt.Fprintf(w, "Usage:\n\n  maltcoin <command> [flags]\n\nCommands:\n\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-17s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(w, "\nRun 'maltcoin <command> --help' for the flags of a command.\n")
+}
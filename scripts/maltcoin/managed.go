@@ -0,0 +1,371 @@
+// managed.go contains the subcommands, which administer the managed variant
+// of the token contract: minting, burning, pausing and the roles, which are
+// required for it.
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// managedOutput is the structured output of the subcommands, which send a
+// transaction to the managed token contract. The account, role and amount
+// are only set for the methods, which take them.
+type managedOutput struct {
+	Contract    common.Address    `json:"contract"`
+	Method      string            `json:"method"`
+	Account     *common.Address   `json:"account,omitempty"`
+	Role        *roleOutput       `json:"role,omitempty"`
+	Amount      *amountOutput     `json:"amount,omitempty"`
+	TotalSupply amountOutput      `json:"total_supply"`
+	Paused      bool              `json:"paused"`
+	Transaction transactionOutput `json:"transaction"`
+}
+
+// roleOutput describes a role of the managed token contract.
+type roleOutput struct {
+	Name string      `json:"name"`
+	Hash common.Hash `json:"hash"`
+}
+
+// roleMemberOutput describes an account, which has a role.
+type roleMemberOutput struct {
+	roleOutput
+	Account common.Address `json:"account"`
+}
+
+// rolesOutput is the structured output of the roles subcommand.
+type rolesOutput struct {
+	Contract common.Address     `json:"contract"`
+	Paused   bool               `json:"paused"`
+	Members  []roleMemberOutput `json:"members"`
+}
+
+// managedTransaction describes the call of a method of the managed token
+// contract.
+type managedTransaction struct {
+	method  string
+	args    []interface{}
+	summary string
+	output  managedOutput
+}
+
+// sendManaged prepares the call of the method of the managed token contract
+// and sends it or, with a dry run, prints its estimate. Afterwards, the total
+// supply and the paused state are printed.
+func (tf *transactionFlags) sendManaged(name string, tt *tokenTransaction, mt managedTransaction) error {
+	managedABI, err := util.GetManagedABI()
+	if err != nil {
+		return err
+	}
+	managed, err := util.GetManagedContract(tt.client, tt.contractAddress)
+	if err != nil {
+		return fmt.Errorf("failed to load token contract: %w", err)
+	}
+
+	callData, err := managedABI.Pack(mt.method, mt.args...)
+	if err != nil {
+		return fmt.Errorf("error while getting the call data: %w", err)
+	}
+	callMsg := ethereum.CallMsg{
+		From: tt.auth.From,
+		To:   &tt.contractAddress,
+		Data: callData,
+	}
+	if tt.auth, err = util.FillTransactionSignerFieldsWithFees(tt.auth, tt.client, callMsg, tt.feeOptions); err != nil {
+		return fmt.Errorf("error while filling the transaction signer fields: %w", err)
+	}
+
+	printHeader("maltcoin "+name, fmt.Sprintf("%s on a managed Maltcoin contract on the %q network.", mt.summary, tt.network))
+	if *tf.dryRun {
+		return tt.dryRun()
+	}
+	fmt.Println("Contract address: ", tt.contractAddress)
+	fmt.Println("Fee mode:         ", util.DescribeFees(tt.auth))
+
+	tx, err := util.TransactMethod(tt.auth, tt.client, managedABI, tt.contractAddress, mt.method, mt.args)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", mt.method, err)
+	}
+	receipt, err := waitForTransaction(tt.client, tx, tt.waitOptions, managedABI)
+	if err != nil {
+		return err
+	}
+
+	opts := &bind.CallOpts{Context: context.Background()}
+	totalSupply, err := managed.TotalSupply(opts)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve total supply: %w", err)
+	}
+	paused, err := managed.Paused(opts)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve paused state: %w", err)
+	}
+	fmt.Println()
+	fmt.Println("Total supply:     ", tt.amount(totalSupply))
+	fmt.Println("Paused:           ", paused)
+
+	output := mt.output
+	output.Contract = tt.contractAddress
+	output.Method = mt.method
+	output.TotalSupply = newAmountOutput(totalSupply, tt.unit)
+	output.Paused = paused
+	output.Transaction = newTransactionOutput(tx, tt.auth.From, receipt)
+
+	return emit(output)
+}
+
+// runMint mints tokens to a recipient.
+func runMint(args []string) error {
+	tf := newTransactionFlags("mint", "Mint new tokens to a recipient. The signer needs the minter role of the managed token contract.")
+	recipientHex := tf.addressFlag("to", "address of the recipient")
+	amountStr := tf.String("amount", "", "amount of tokens, e.g. 1.5 or 1.5MALT (required)")
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	recipient, err := parseAddress("to", *recipientHex)
+	if err != nil {
+		return err
+	}
+	if err := requireAmount("amount", *amountStr); err != nil {
+		return err
+	}
+	tt, _, err := tf.connect(contractAddress, privKey)
+	if err != nil {
+		return err
+	}
+	defer tt.client.Close()
+
+	amount, err := parseAmount("amount", *amountStr, tt.unit)
+	if err != nil {
+		return err
+	}
+	amountOut := newAmountOutput(amount, tt.unit)
+
+	return tf.sendManaged("mint", tt, managedTransaction{
+		method:  "mint",
+		args:    []interface{}{recipient, amount},
+		summary: fmt.Sprintf("Mints %v to %s", tt.amount(amount), recipient),
+		output:  managedOutput{Account: &recipient, Amount: &amountOut},
+	})
+}
+
+// runBurn burns tokens of the signer or, with an allowance, of an owner.
+func runBurn(args []string) error {
+	tf := newTransactionFlags("burn", "Burn tokens of the signer or, with --from, tokens of an owner, who approved the signer to spend them.")
+	ownerHex := tf.String("from", "", "address of the owner of the burned tokens, hex or bech32 (default: the signer)")
+	amountStr := tf.String("amount", "", "amount of tokens, e.g. 1.5 or 1.5MALT (required)")
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	var owner *common.Address
+	if *ownerHex != "" {
+		parsed, err := parseAddress("from", *ownerHex)
+		if err != nil {
+			return err
+		}
+		owner = &parsed
+	}
+	if err := requireAmount("amount", *amountStr); err != nil {
+		return err
+	}
+	tt, _, err := tf.connect(contractAddress, privKey)
+	if err != nil {
+		return err
+	}
+	defer tt.client.Close()
+
+	amount, err := parseAmount("amount", *amountStr, tt.unit)
+	if err != nil {
+		return err
+	}
+	amountOut := newAmountOutput(amount, tt.unit)
+
+	mt := managedTransaction{
+		method:  "burn",
+		args:    []interface{}{amount},
+		summary: fmt.Sprintf("Burns %v of the signer", tt.amount(amount)),
+		output:  managedOutput{Account: &tt.auth.From, Amount: &amountOut},
+	}
+	if owner != nil {
+		mt.method = "burnFrom"
+		mt.args = []interface{}{*owner, amount}
+		mt.summary = fmt.Sprintf("Burns %v of %s", tt.amount(amount), *owner)
+		mt.output.Account = owner
+	}
+
+	return tf.sendManaged("burn", tt, mt)
+}
+
+// runPause pauses all transfers of the token.
+func runPause(args []string) error {
+	return runPauseMethod(args, "pause", "Pause all transfers, mints and burns of the token. The signer needs the pauser role.", "Pauses the token")
+}
+
+// runUnpause resumes the transfers of the token.
+func runUnpause(args []string) error {
+	return runPauseMethod(args, "unpause", "Resume the transfers, mints and burns of a paused token. The signer needs the pauser role.", "Unpauses the token")
+}
+
+// runPauseMethod calls the pause or unpause method, which have no arguments.
+func runPauseMethod(args []string, method, description, summary string) error {
+	tf := newTransactionFlags(method, description)
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	tt, _, err := tf.connect(contractAddress, privKey)
+	if err != nil {
+		return err
+	}
+	defer tt.client.Close()
+
+	return tf.sendManaged(method, tt, managedTransaction{method: method, summary: summary})
+}
+
+// runGrantRole grants a role to an account.
+func runGrantRole(args []string) error {
+	return runRoleMethod(args, "grant-role", "grantRole", "Grant a role to an account. The signer needs the admin role.", "Grants the %s role to %s")
+}
+
+// runRevokeRole revokes a role from an account.
+func runRevokeRole(args []string) error {
+	return runRoleMethod(args, "revoke-role", "revokeRole", "Revoke a role from an account. The signer needs the admin role.", "Revokes the %s role from %s")
+}
+
+// runRoleMethod calls the grantRole or revokeRole method for the role and
+// account given with the flags.
+func runRoleMethod(args []string, name, method, description, summary string) error {
+	tf := newTransactionFlags(name, description)
+	roleStr := tf.String("role", "", "role name (admin, minter or pauser) or role hash as 32 bytes hex (required)")
+	accountHex := tf.addressFlag("address", "address of the account")
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	if *roleStr == "" {
+		return usageErrorf("--role is required")
+	}
+	role, err := util.ParseRole(*roleStr)
+	if err != nil {
+		return &usageError{err: err}
+	}
+	account, err := parseAddress("address", *accountHex)
+	if err != nil {
+		return err
+	}
+	tt, _, err := tf.connect(contractAddress, privKey)
+	if err != nil {
+		return err
+	}
+	defer tt.client.Close()
+
+	return tf.sendManaged(name, tt, managedTransaction{
+		method:  method,
+		args:    []interface{}{role.Hash, account},
+		summary: fmt.Sprintf(summary, role.Name, account),
+		output:  managedOutput{Account: &account, Role: &roleOutput{Name: role.Name, Hash: role.Hash}},
+	})
+}
+
+// runRoles prints the members of the roles of a managed token contract or,
+// with --address, the roles of a single account.
+func runRoles(args []string) error {
+	cf := newCommandFlags("roles", "Print the accounts, which have the roles of a managed token contract. Because the contract does not\n"+
+		"enumerate the members, they are collected from the RoleGranted and RoleRevoked events.")
+	contractHex := cf.addressFlag("contract", "address of the managed token contract")
+	accountHex := cf.String("address", "", "only print the roles of this account, hex or bech32")
+	fromBlock := cf.Uint64("from-block", 0, "first block to collect role events from")
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	var account *common.Address
+	if *accountHex != "" {
+		parsed, err := parseAddress("address", *accountHex)
+		if err != nil {
+			return err
+		}
+		account = &parsed
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	client, err := util.GetClient(profile)
+	if err != nil {
+		return fmt.Errorf("failed to connect to node: %w", err)
+	}
+	defer client.Close()
+
+	contract, err := util.GetManagedContract(client, contractAddress)
+	if err != nil {
+		return fmt.Errorf("failed to load token contract: %w", err)
+	}
+	ctx := context.Background()
+	paused, err := contract.Paused(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve paused state: %w", err)
+	}
+
+	var members []util.RoleMember
+	if account != nil {
+		roles, err := util.GetRoles(&bind.CallOpts{Context: ctx}, contract, *account)
+		if err != nil {
+			return err
+		}
+		for _, role := range roles {
+			members = append(members, util.RoleMember{Role: role, Account: *account})
+		}
+	} else {
+		members, err = util.GetRoleMembers(&bind.FilterOpts{Start: *fromBlock, Context: ctx}, contract)
+		if err != nil {
+			return fmt.Errorf("failed to collect role events: %w", err)
+		}
+	}
+
+	printHeader("maltcoin roles", fmt.Sprintf("Lists the roles of a managed Maltcoin contract on the %q network.", profile.Name))
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Paused:           ", paused)
+	fmt.Printf("\n  ROLE   |                   ACCOUNT                   \n")
+	fmt.Printf("---------|---------------------------------------------\n")
+	output := rolesOutput{Contract: contractAddress, Paused: paused, Members: make([]roleMemberOutput, 0, len(members))}
+	for _, member := range members {
+		fmt.Printf("%-8s | %v\n", member.Role.Name, member.Account)
+		output.Members = append(output.Members, roleMemberOutput{
+			roleOutput: roleOutput{Name: member.Role.Name, Hash: member.Role.Hash},
+			Account:    member.Account,
+		})
+	}
+	fmt.Println()
+
+	return emit(output)
+}
@@ -0,0 +1,374 @@
+// output.go contains the machine-readable output of the subcommands. Every
+// subcommand accepts --output to print its result as JSON, YAML or as a
+// table of key-value pairs instead of the human-readable text.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats of the subcommands
+const (
+	outputText  = "text"
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// Error codes of structured errors
+const (
+	codeUsage             = "usage_error"
+	codeReverted          = "execution_reverted"
+	codeTransactionFailed = "transaction_failed"
+	codeTimeout           = "timeout"
+	codeAccountNotFound   = "account_not_found"
+	codeNoContractCode    = "no_contract_code"
+	codeCodeMismatch      = "code_mismatch"
+	codeCreate2Mismatch   = "create2_mismatch"
+	codeChainIDMismatch   = "chain_id_mismatch"
+	codeInsufficient      = "insufficient_balance"
+	codeInsufficientFunds = "insufficient_funds"
+	codeJournalMismatch   = "journal_mismatch"
+	codeSnapshotMismatch  = "snapshot_mismatch"
+	codeStoreMismatch     = "store_mismatch"
+	codeNotIndexed        = "not_indexed"
+	codeInvalidPermit     = "invalid_permit"
+	codePermitExpired     = "permit_expired"
+	codePermitNonce       = "permit_nonce_mismatch"
+	codeError             = "error"
+)
+
+var (
+	// outputFormat is the format selected with the --output flag of the
+	// running subcommand. Only one subcommand runs per process, so that
+	// errors can be reported in the same format after the command returned.
+	outputFormat = outputText
+
+	// document is the writer of the structured output. While a structured
+	// format is selected, the human-readable messages of the subcommands
+	// are written to stderr, so that stdout only contains the document.
+	document io.Writer = os.Stdout
+
+	// emitted is true, once the result of the subcommand was written. A
+	// subcommand, which fails after writing its result, e.g. a batch with
+	// failed transfers, signals the error only with the exit code, so that
+	// stdout contains a single document.
+	emitted bool
+)
+
+// outputFlag registers the flag, which selects the output format.
+func outputFlag(fs *flag.FlagSet) *string {
+	return fs.String("output", outputText, "output format: text, table, json or yaml")
+}
+
+// selectOutput validates and selects the given output format. For
+// structured formats, stdout is redirected to stderr until restoreOutput is
+// called.
+func selectOutput(format string) error {
+	switch format {
+	case outputText:
+		return nil
+	case outputTable, outputJSON, outputYAML:
+	default:
+		return usageErrorf("--output: unknown format %q, must be text, table, json or yaml", format)
+	}
+
+	outputFormat = format
+	document = os.Stdout
+	os.Stdout = os.Stderr
+
+	return nil
+}
+
+// restoreOutput resets stdout and the output format after a subcommand.
+func restoreOutput() {
+	if file, ok := document.(*os.File); ok {
+		os.Stdout = file
+	}
+	outputFormat, document, emitted = outputText, os.Stdout, false
+}
+
+// emit writes the result of a subcommand in the selected structured format.
+// In text mode, the subcommands print their results themselves, so that
+// nothing is written.
+func emit(result interface{}) error {
+	if outputFormat == outputText {
+		return nil
+	}
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	emitted = true
+
+	switch outputFormat {
+	case outputJSON:
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, bz, "", "  "); err != nil {
+			return err
+		}
+		indented.WriteByte('\n')
+		_, err = indented.WriteTo(document)
+		return err
+	case outputYAML:
+		node, err := yamlNode(bz)
+		if err != nil {
+			return err
+		}
+		encoder := yaml.NewEncoder(document)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(node); err != nil {
+			return err
+		}
+		return encoder.Close()
+	default:
+		node, err := yamlNode(bz)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(document, 0, 0, 2, ' ', 0)
+		writeTable(w, "", node)
+		return w.Flush()
+	}
+}
+
+// yamlNode parses the JSON document into a YAML node, which keeps the
+// order of the fields. JSON is valid YAML, so only the flow style of the
+// JSON syntax has to be reset.
+func yamlNode(bz []byte) (*yaml.Node, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(bz, &node); err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+
+	return &node, nil
+}
+
+// resetStyle resets the style of the node and its children to the default
+// block style.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// writeTable writes the scalar values of the node as rows with their
+// dot-separated path, e.g. "holders.0.address".
+func writeTable(w io.Writer, path string, node *yaml.Node) {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			writeTable(w, path, child)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			writeTable(w, join(node.Content[i].Value), node.Content[i+1])
+		}
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			fmt.Fprintf(w, "%s\t\n", path)
+		}
+		for i, child := range node.Content {
+			writeTable(w, join(fmt.Sprint(i)), child)
+		}
+	default:
+		value := node.Value
+		if node.Tag == "!!null" {
+			value = ""
+		}
+		fmt.Fprintf(w, "%s\t%s\n", path, value)
+	}
+}
+
+// errorOutput is the structured form of an error of a subcommand.
+type errorOutput struct {
+	Error errorDetails `json:"error"`
+}
+
+// errorDetails describes an error with a stable code, the exit code of the
+// command and the message. Reverts and failed transactions carry their
+// reason and transaction hash.
+type errorDetails struct {
+	Code     string `json:"code"`
+	ExitCode int    `json:"exit_code"`
+	Message  string `json:"message"`
+	Reason   string `json:"reason,omitempty"`
+	TxHash   string `json:"tx_hash,omitempty"`
+}
+
+// emitError writes the error in the selected structured format, unless the
+// result of the subcommand was already written.
+func emitError(err error, exitCode int) {
+	if emitted {
+		return
+	}
+	details := errorDetails{Code: errorCode(err), ExitCode: exitCode, Message: err.Error()}
+
+	var failure *util.FailedTransactionError
+	if errors.As(err, &failure) {
+		details.TxHash = failure.TxHash.Hex()
+		details.Reason = failure.Reason.Error()
+	} else if errors.Is(err, util.ErrExecutionReverted) {
+		var revertErr *util.RevertError
+		if errors.As(err, &revertErr) {
+			details.Reason = revertErr.Reason
+		}
+	}
+
+	if emitErr := emit(errorOutput{Error: details}); emitErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to write error: %v\n", emitErr)
+	}
+}
+
+// errorCode returns the code of the given error for structured output.
+func errorCode(err error) string {
+	var usageErr *usageError
+	switch {
+	case errors.As(err, &usageErr):
+		return codeUsage
+	case errors.Is(err, util.ErrTransactionFailed):
+		return codeTransactionFailed
+	case errors.Is(err, util.ErrExecutionReverted):
+		return codeReverted
+	case errors.Is(err, util.ErrWaitTimeout):
+		return codeTimeout
+	case errors.Is(err, util.ErrAccountNotFound):
+		return codeAccountNotFound
+	case errors.Is(err, util.ErrNoContractCode):
+		return codeNoContractCode
+	case errors.Is(err, util.ErrCodeMismatch):
+		return codeCodeMismatch
+	case errors.Is(err, util.ErrCreate2AddressMismatch):
+		return codeCreate2Mismatch
+	case errors.Is(err, util.ErrChainIDMismatch):
+		return codeChainIDMismatch
+	case errors.Is(err, util.ErrInsufficientBalance):
+		return codeInsufficient
+	case errors.Is(err, util.ErrInsufficientFunds):
+		return codeInsufficientFunds
+	case errors.Is(err, util.ErrJournalMismatch):
+		return codeJournalMismatch
+	case errors.Is(err, util.ErrSnapshotMismatch):
+		return codeSnapshotMismatch
+	case errors.Is(err, util.ErrStoreMismatch):
+		return codeStoreMismatch
+	case errors.Is(err, util.ErrNotIndexed):
+		return codeNotIndexed
+	case errors.Is(err, util.ErrInvalidPermit):
+		return codeInvalidPermit
+	case errors.Is(err, util.ErrPermitExpired):
+		return codePermitExpired
+	case errors.Is(err, util.ErrPermitNonce):
+		return codePermitNonce
+	}
+
+	return codeError
+}
+
+// amountOutput is a token amount in the smallest unit together with the
+// amount in the unit of the command, i.e. whole tokens or, with --raw, the
+// smallest unit. The amounts are strings, so that no precision is lost.
+type amountOutput struct {
+	Value  string `json:"value"`
+	Amount string `json:"amount"`
+	Unit   string `json:"unit"`
+
+	// formatted is the human-readable amount for the text output.
+	formatted string
+}
+
+// newAmountOutput returns the output of the given amount.
+func newAmountOutput(value *big.Int, unit util.TokenUnit) amountOutput {
+	amount := util.NewTokenAmount(value, unit)
+
+	return amountOutput{Value: value.String(), Amount: amount.Plain(), Unit: unit.Name(), formatted: amount.String()}
+}
+
+// String returns the amount with thousands separators and unit name.
+func (a amountOutput) String() string {
+	return a.formatted
+}
+
+// balanceOutput is the token balance of an address.
+type balanceOutput struct {
+	Address common.Address `json:"address"`
+	Balance amountOutput   `json:"balance"`
+}
+
+// feesOutput describes the fees of a transaction in wei. Dynamic fee
+// transactions have a max fee and a max priority fee per gas, legacy
+// transactions a gas price.
+type feesOutput struct {
+	Mode                 string `json:"mode"`
+	GasPrice             string `json:"gas_price,omitempty"`
+	MaxFeePerGas         string `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas,omitempty"`
+}
+
+// transactionOutput describes a sent transaction and, if it was confirmed,
+// the block and gas usage from its receipt.
+type transactionOutput struct {
+	Hash        common.Hash    `json:"hash"`
+	From        common.Address `json:"from"`
+	Nonce       uint64         `json:"nonce"`
+	GasLimit    uint64         `json:"gas_limit"`
+	Fees        feesOutput     `json:"fees"`
+	Status      uint64         `json:"status"`
+	BlockNumber uint64         `json:"block_number"`
+	GasUsed     uint64         `json:"gas_used"`
+}
+
+// newTransactionOutput returns the output of the transaction, which was
+// sent by the given address and confirmed with the given receipt.
+func newTransactionOutput(tx *types.Transaction, from common.Address, receipt *types.Receipt) transactionOutput {
+	output := transactionOutput{
+		Hash:     tx.Hash(),
+		From:     from,
+		Nonce:    tx.Nonce(),
+		GasLimit: tx.Gas(),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		output.Fees = feesOutput{
+			Mode:                 "dynamic",
+			MaxFeePerGas:         tx.GasFeeCap().String(),
+			MaxPriorityFeePerGas: tx.GasTipCap().String(),
+		}
+	} else {
+		output.Fees = feesOutput{Mode: "legacy", GasPrice: tx.GasPrice().String()}
+	}
+	if receipt != nil {
+		output.Status = receipt.Status
+		output.BlockNumber = receipt.BlockNumber.Uint64()
+		output.GasUsed = receipt.GasUsed
+	}
+
+	return output
+}
+
+// structuredOutput reports, if a structured output format is selected.
+func structuredOutput() bool {
+	return outputFormat != outputText
+}
@@ -0,0 +1,319 @@
+// permit.go contains the subcommands for EIP-2612 permits: sign-permit,
+// with which a holder signs an approval off-chain, and relay-permit, with
+// which the spender submits the signed permit and transfers the approved
+// tokens, so that the holder needs no native tokens to pay fees.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// signPermitOutput is the structured output of the sign-permit subcommand.
+type signPermitOutput struct {
+	Contract common.Address `json:"contract"`
+	Owner    common.Address `json:"owner"`
+	Spender  common.Address `json:"spender"`
+	Amount   amountOutput   `json:"amount"`
+	Nonce    uint64         `json:"nonce"`
+	Deadline uint64         `json:"deadline"`
+	Expires  string         `json:"expires"`
+	File     string         `json:"file"`
+}
+
+// relayPermitOutput is the structured output of the relay-permit
+// subcommand. The balances of the owner and the recipient are given before
+// and after the transfer. The permit is null, if the allowance was already
+// set, so that no permit transaction was sent.
+type relayPermitOutput struct {
+	Contract       common.Address     `json:"contract"`
+	Owner          common.Address     `json:"owner"`
+	Spender        common.Address     `json:"spender"`
+	To             common.Address     `json:"to"`
+	Amount         amountOutput       `json:"amount"`
+	Permit         *transactionOutput `json:"permit"`
+	Transfer       transactionOutput  `json:"transfer"`
+	BalancesBefore []balanceOutput    `json:"balances_before"`
+	BalancesAfter  []balanceOutput    `json:"balances_after"`
+}
+
+// runSignPermit signs a permit, which approves a spender, and writes it to
+// a file, which is handed to the spender.
+func runSignPermit(args []string) error {
+	cf := newCommandFlags("sign-permit", "Sign an EIP-2612 permit, which approves a spender to transfer up to the given amount of tokens "+
+		"on behalf of the signer, and write it to a file. No transaction is sent, so that the signer needs no native tokens. "+
+		"The spender submits the permit with relay-permit.")
+	contractHex := cf.addressFlag("contract", "address of the token contract")
+	spenderHex := cf.addressFlag("spender", "address, which is allowed to spend the tokens and relays the permit")
+	amountStr := cf.String("amount", "", "amount of tokens, e.g. 1.5 or 1.5MALT (required)")
+	expiresIn := cf.Duration("expires-in", time.Hour, "validity of the permit from the time of the latest block")
+	out := cf.String("out", "", "file to write the signed permit to (default: permit-<owner>-<nonce>.json)")
+	raw := cf.rawFlag()
+	signer := cf.signerFlags()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	spender, err := parseAddress("spender", *spenderHex)
+	if err != nil {
+		return err
+	}
+	if err := requireAmount("amount", *amountStr); err != nil {
+		return err
+	}
+	if *expiresIn <= 0 {
+		return usageErrorf("--expires-in must be positive")
+	}
+	privKey, err := signer.privateKey()
+	if err != nil {
+		return err
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	client, err := util.GetClient(profile)
+	if err != nil {
+		return fmt.Errorf("failed to connect to node: %w", err)
+	}
+	defer client.Close()
+
+	unit, err := tokenUnit(client, contractAddress, *raw)
+	if err != nil {
+		return err
+	}
+	amount, err := parseAmount("amount", *amountStr, unit)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	deadline, err := util.PermitDeadline(ctx, client, *expiresIn)
+	if err != nil {
+		return fmt.Errorf("failed to compute deadline: %w", err)
+	}
+	permit, err := util.SignPermit(ctx, client, contractAddress, privKey, spender, amount, deadline)
+	if err != nil {
+		return fmt.Errorf("failed to sign permit: %w", err)
+	}
+	if *out == "" {
+		*out = fmt.Sprintf("permit-%s-%v.json", permit.Owner.Hex(), permit.Nonce)
+	}
+	if err := writePermit(*out, permit); err != nil {
+		return fmt.Errorf("failed to write permit: %w", err)
+	}
+
+	expires := time.Unix(int64(deadline.Uint64()), 0).UTC()
+	printHeader("maltcoin sign-permit", fmt.Sprintf("Signs a permit for a Maltcoin contract on the %q network.", profile.Name))
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Owner:            ", permit.Owner)
+	fmt.Println("Spender:          ", spender)
+	fmt.Println("Amount:           ", util.NewTokenAmount(amount, unit))
+	fmt.Println("Nonce:            ", permit.Nonce)
+	fmt.Println("Expires:          ", expires.Format(time.RFC3339))
+	fmt.Println()
+	fmt.Printf("The signed permit was written to %s. The spender submits it with relay-permit.\n", *out)
+
+	return emit(signPermitOutput{
+		Contract: contractAddress,
+		Owner:    permit.Owner,
+		Spender:  spender,
+		Amount:   newAmountOutput(amount, unit),
+		Nonce:    permit.Nonce.Uint64(),
+		Deadline: deadline.Uint64(),
+		Expires:  expires.Format(time.RFC3339),
+		File:     *out,
+	})
+}
+
+// writePermit writes the signed permit to the file.
+func writePermit(path string, permit *util.SignedPermit) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := util.WritePermit(f, permit); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// readPermit reads the signed permit from the file.
+func readPermit(path string) (*util.SignedPermit, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	permit, err := util.ReadPermit(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read permit file %s: %w", path, err)
+	}
+
+	return permit, nil
+}
+
+// runRelayPermit submits a signed permit and transfers the approved tokens
+// from the owner to a recipient. The signer has to be the spender of the
+// permit and pays the fees of both transactions. If the allowance is already
+// set, e.g. because a previous run failed after the permit, only the tokens
+// are transferred.
+func runRelayPermit(args []string) error {
+	cf := newCommandFlags("relay-permit", "Submit a signed EIP-2612 permit and transfer the approved tokens from the owner to a recipient. "+
+		"The signer has to be the spender of the permit and pays the fees of both transactions. Expired and already used "+
+		"permits are rejected before any transaction is sent, unless the allowance already covers the amount, in which case "+
+		"only the tokens are transferred.")
+	// The contract is taken from the permit file, so that there is no
+	// --contract flag
+	var contractHex string
+	tf := &transactionFlags{
+		commandFlags: cf,
+		contract:     &contractHex,
+		raw:          cf.rawFlag(),
+		dryRun:       cf.dryRunFlag(),
+		signer:       cf.signerFlags(),
+		wait:         cf.waitFlags(),
+	}
+	file := cf.String("file", "", "file with the signed permit, which was written by sign-permit (required)")
+	recipientHex := cf.addressFlag("to", "address of the recipient")
+	amountStr := cf.String("amount", "", "amount of tokens to transfer, e.g. 1.5 or 1.5MALT (default: the permitted amount)")
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	if *file == "" {
+		return usageErrorf("--file is required")
+	}
+	recipient, err := parseAddress("to", *recipientHex)
+	if err != nil {
+		return err
+	}
+	permit, err := readPermit(*file)
+	if err != nil {
+		return err
+	}
+	contractHex = permit.Contract.Hex()
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	tt, profile, err := tf.connect(contractAddress, privKey)
+	if err != nil {
+		return err
+	}
+	defer tt.client.Close()
+
+	amount := permit.Value
+	if *amountStr != "" {
+		if amount, err = parseAmount("amount", *amountStr, tt.unit); err != nil {
+			return err
+		}
+		if amount.Cmp(permit.Value) > 0 {
+			return usageErrorf("--amount %v exceeds the permitted %v", tt.amount(amount), tt.amount(permit.Value))
+		}
+	}
+	if permit.Spender != tt.auth.From {
+		return usageErrorf("the permit approves %s, but the signer is %s", permit.Spender, tt.auth.From)
+	}
+
+	// A permit, which was already submitted, e.g. by a previous run, which
+	// failed before the transfer, is used up, so that the allowance is
+	// checked first
+	allowance, err := tt.contract.Allowance(nil, permit.Owner, permit.Spender)
+	if err != nil {
+		return fmt.Errorf("failed to get allowance: %w", err)
+	}
+	submitPermit := allowance.Cmp(amount) < 0
+	if submitPermit {
+		// Reject permits, which the contract would reject, before sending
+		// any transaction
+		if err := util.CheckPermit(context.Background(), tt.client, permit); err != nil {
+			return err
+		}
+		if err := tt.prepare("permit", permit.Owner, permit.Spender, permit.Value, permit.Deadline, permit.V, [32]byte(permit.R), [32]byte(permit.S)); err != nil {
+			return err
+		}
+	} else if err := tt.prepare("transferFrom", permit.Owner, recipient, amount); err != nil {
+		return err
+	}
+
+	printHeader("maltcoin relay-permit", fmt.Sprintf("Relays a permit and transfers the approved tokens on a Maltcoin contract on the %q network.", profile.Name))
+	if *tf.dryRun {
+		if submitPermit {
+			// The transfer can only be estimated, once the permit set the
+			// allowance, so that only the permit is estimated
+			fmt.Printf("Permit of %v from %s for %s\n", tt.amount(permit.Value), permit.Owner, permit.Spender)
+		} else {
+			fmt.Printf("Transfer of %v from %s to %s with the existing allowance of %v\n", tt.amount(amount), permit.Owner, recipient, tt.amount(allowance))
+		}
+		return tt.dryRun()
+	}
+	fmt.Println("Maltcoin contract loaded at address: ", contractAddress)
+	fmt.Println("Owner:                               ", permit.Owner)
+	fmt.Println("Spender:                             ", permit.Spender)
+	fmt.Println("Fee mode:                            ", util.DescribeFees(tt.auth))
+	before, err := printBalances(tt.contract, tt.unit, "Account balances pre transaction", permit.Owner, recipient)
+	if err != nil {
+		return err
+	}
+
+	var permitOutput *transactionOutput
+	if submitPermit {
+		permitTx, err := tt.contract.Permit(tt.auth, permit.Owner, permit.Spender, permit.Value, permit.Deadline, permit.V, permit.R, permit.S)
+		if err != nil {
+			return fmt.Errorf("failed to submit permit: %w", err)
+		}
+		fmt.Printf("Submitting permit of %v in tx %v\n", tt.amount(permit.Value), permitTx.Hash().Hex())
+		permitReceipt, err := waitForTransaction(tt.client, permitTx, tt.waitOptions)
+		if err != nil {
+			return err
+		}
+		output := newTransactionOutput(permitTx, tt.auth.From, permitReceipt)
+		permitOutput = &output
+
+		if err := tt.prepare("transferFrom", permit.Owner, recipient, amount); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("Skipping the permit, because the allowance of %v already covers the transfer\n", tt.amount(allowance))
+	}
+	transferTx, err := tt.contract.TransferFrom(tt.auth, permit.Owner, recipient, amount)
+	if err != nil {
+		return fmt.Errorf("failed to transfer tokens: %w", err)
+	}
+	fmt.Printf("Transferring %v in tx %v\n", tt.amount(amount), transferTx.Hash().Hex())
+	transferReceipt, err := waitForTransaction(tt.client, transferTx, tt.waitOptions)
+	if err != nil {
+		return err
+	}
+
+	after, err := printBalances(tt.contract, tt.unit, "Account balances post transaction", permit.Owner, recipient)
+	if err != nil {
+		return err
+	}
+
+	return emit(relayPermitOutput{
+		Contract:       contractAddress,
+		Owner:          permit.Owner,
+		Spender:        permit.Spender,
+		To:             recipient,
+		Amount:         newAmountOutput(amount, tt.unit),
+		Permit:         permitOutput,
+		Transfer:       newTransactionOutput(transferTx, tt.auth.From, transferReceipt),
+		BalancesBefore: before,
+		BalancesAfter:  after,
+	})
+}
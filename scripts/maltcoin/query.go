@@ -0,0 +1,217 @@
+// query.go contains the subcommands, which query the state of a token
+// contract without sending any transactions.
+package main
+
+import (
+	"fmt"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// infoOutput is the structured output of the info subcommand.
+type infoOutput struct {
+	Contract    common.Address `json:"contract"`
+	Name        string         `json:"name"`
+	Symbol      string         `json:"symbol"`
+	Decimals    uint8          `json:"decimals"`
+	TotalSupply amountOutput   `json:"total_supply"`
+}
+
+// balanceQueryOutput is the structured output of the balance subcommand.
+type balanceQueryOutput struct {
+	Contract common.Address `json:"contract"`
+	balanceOutput
+}
+
+// allowanceOutput is the structured output of the allowance subcommand.
+type allowanceOutput struct {
+	Contract  common.Address `json:"contract"`
+	Owner     common.Address `json:"owner"`
+	Spender   common.Address `json:"spender"`
+	Allowance amountOutput   `json:"allowance"`
+}
+
+// loadContract connects to the node of the given network profile and
+// loads the token contract at the given address.
+func loadContract(profile util.NetworkProfile, contractAddress common.Address) (*ethclient.Client, *maltcoin.Maltcoin, error) {
+	client, err := util.GetClient(profile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to node: %w", err)
+	}
+
+	contract, err := util.GetContract(client, contractAddress)
+	if err != nil {
+		client.Close()
+		return nil, nil, fmt.Errorf("failed to load token contract: %w", err)
+	}
+
+	return client, contract, nil
+}
+
+// runInfo prints the properties of the token contract.
+func runInfo(args []string) error {
+	cf := newCommandFlags("info", "Print the name, symbol, decimals and total supply of a token contract.")
+	contractHex := cf.addressFlag("contract", "address of the token contract")
+	raw := cf.rawFlag()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	client, contract, err := loadContract(profile, contractAddress)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	name, err := contract.Name(nil)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve token name: %w", err)
+	}
+	symbol, err := contract.Symbol(nil)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve token symbol: %w", err)
+	}
+	decimals, err := contract.Decimals(nil)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve token decimals: %w", err)
+	}
+	totalSupply, err := contract.TotalSupply(nil)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve total supply: %w", err)
+	}
+
+	printHeader("maltcoin info", "Prints the properties of a Maltcoin token contract.")
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Token name:       ", name)
+	fmt.Println("Token symbol:     ", symbol)
+	fmt.Println("Decimals:         ", decimals)
+	unit := util.TokenUnit{Symbol: symbol, Decimals: decimals, Raw: *raw}
+	fmt.Println("Total supply:     ", util.NewTokenAmount(totalSupply, unit))
+
+	return emit(infoOutput{
+		Contract:    contractAddress,
+		Name:        name,
+		Symbol:      symbol,
+		Decimals:    decimals,
+		TotalSupply: newAmountOutput(totalSupply, unit),
+	})
+}
+
+// runBalance prints the token balance of an address.
+func runBalance(args []string) error {
+	cf := newCommandFlags("balance", "Print the token balance of an address.")
+	contractHex := cf.addressFlag("contract", "address of the token contract")
+	accountHex := cf.addressFlag("address", "address, which holds the tokens")
+	raw := cf.rawFlag()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	account, err := parseAddress("address", *accountHex)
+	if err != nil {
+		return err
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	client, contract, err := loadContract(profile, contractAddress)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	unit, err := tokenUnit(client, contractAddress, *raw)
+	if err != nil {
+		return err
+	}
+	balance, err := contract.BalanceOf(nil, account)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve balance: %w", err)
+	}
+
+	printHeader("maltcoin balance", "Prints the token balance of an address.")
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Address:          ", account)
+	fmt.Println("Balance:          ", util.NewTokenAmount(balance, unit))
+
+	return emit(balanceQueryOutput{
+		Contract:      contractAddress,
+		balanceOutput: balanceOutput{Address: account, Balance: newAmountOutput(balance, unit)},
+	})
+}
+
+// runAllowance prints the amount of tokens a spender is allowed to
+// transfer on behalf of an owner.
+func runAllowance(args []string) error {
+	cf := newCommandFlags("allowance", "Print the amount of tokens a spender is allowed to transfer on behalf of an owner.")
+	contractHex := cf.addressFlag("contract", "address of the token contract")
+	ownerHex := cf.addressFlag("owner", "address, which holds the tokens")
+	spenderHex := cf.addressFlag("spender", "address, which is allowed to spend the tokens")
+	raw := cf.rawFlag()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	owner, err := parseAddress("owner", *ownerHex)
+	if err != nil {
+		return err
+	}
+	spender, err := parseAddress("spender", *spenderHex)
+	if err != nil {
+		return err
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	client, contract, err := loadContract(profile, contractAddress)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	unit, err := tokenUnit(client, contractAddress, *raw)
+	if err != nil {
+		return err
+	}
+	allowance, err := contract.Allowance(nil, owner, spender)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve allowance: %w", err)
+	}
+
+	printHeader("maltcoin allowance", "Prints the amount of tokens a spender may transfer on behalf of an owner.")
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Owner:            ", owner)
+	fmt.Println("Spender:          ", spender)
+	fmt.Println("Allowance:        ", util.NewTokenAmount(allowance, unit))
+
+	return emit(allowanceOutput{
+		Contract:  contractAddress,
+		Owner:     owner,
+		Spender:   spender,
+		Allowance: newAmountOutput(allowance, unit),
+	})
+}
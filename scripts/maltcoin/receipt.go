@@ -0,0 +1,227 @@
+// receipt.go contains the receipt subcommand, which prints information
+// from the receipt of a transaction.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// receiptOutput is the structured output of the receipt subcommand. The
+// code size is only given for contract deployments and the failure reason
+// only for failed transactions.
+type receiptOutput struct {
+	TxHash          common.Hash    `json:"tx_hash"`
+	BlockNumber     uint64         `json:"block_number"`
+	ContractAddress common.Address `json:"contract_address"`
+	Status          uint64         `json:"status"`
+	FailureReason   string         `json:"failure_reason,omitempty"`
+	GasUsed         uint64         `json:"gas_used"`
+	Logs            []logOutput    `json:"logs"`
+	CodeSize        *int           `json:"code_size,omitempty"`
+}
+
+// logOutput is an event log of a receipt. Decoded logs contain the event
+// name and fields, unknown logs the raw topics and data.
+type logOutput struct {
+	Index   uint           `json:"index"`
+	Address common.Address `json:"address"`
+	Event   string         `json:"event,omitempty"`
+	Fields  []fieldOutput  `json:"fields,omitempty"`
+	Topics  []common.Hash  `json:"topics,omitempty"`
+	Data    string         `json:"data,omitempty"`
+}
+
+// fieldOutput is a decoded argument of an event log. Token amounts of
+// Transfer and Approval events are also given in the unit of the token.
+type fieldOutput struct {
+	Name   string        `json:"name"`
+	Type   string        `json:"type"`
+	Value  string        `json:"value"`
+	Amount *amountOutput `json:"amount,omitempty"`
+}
+
+// runReceipt prints the receipt of the given transaction and the size
+// of the code of a deployed contract.
+func runReceipt(args []string) error {
+	cf := newCommandFlags("receipt", "Print values from the receipt of a transaction. For contract deployments, the size of the deployed code is printed as well.")
+	txHashHex := cf.String("tx", "", "hash of the transaction (required)")
+	var abiPaths stringList
+	cf.Var(&abiPaths, "abi", "path to a JSON ABI file to decode logs with in addition to the Maltcoin ABI (can be repeated)")
+	raw := cf.rawFlag()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	txHash, err := parseTxHash("tx", *txHashHex)
+	if err != nil {
+		return err
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+	abis := make([]*abi.ABI, len(abiPaths))
+	for i, path := range abiPaths {
+		if abis[i], err = util.LoadABI(path); err != nil {
+			return usageErrorf("--abi: %v", err)
+		}
+	}
+	decoder, err := util.NewMaltcoinLogDecoder(abis...)
+	if err != nil {
+		return err
+	}
+
+	client, err := util.GetClient(profile)
+	if err != nil {
+		return fmt.Errorf("failed to connect to node: %w", err)
+	}
+	defer client.Close()
+
+	// Get transaction receipt using the client and transaction hash
+	receipt, err := util.GetReceipt(client, txHash.Hex())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve receipt: %w", err)
+	}
+
+	output := receiptOutput{
+		TxHash:          txHash,
+		BlockNumber:     receipt.BlockNumber.Uint64(),
+		ContractAddress: receipt.ContractAddress,
+		Status:          receipt.Status,
+		GasUsed:         receipt.GasUsed,
+		Logs:            logOutputs(client, decoder.DecodeAll(receipt.Logs), *raw),
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		output.FailureReason = failureReason(client, txHash, abis)
+	}
+
+	// Get the code stored at the contract address
+	if (receipt.ContractAddress != common.Address{}) {
+		code, err := client.CodeAt(context.Background(), receipt.ContractAddress, nil)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve code: %w", err)
+		}
+		codeSize := len(code)
+		output.CodeSize = &codeSize
+	}
+
+	// Print information to terminal output
+	printHeader("maltcoin receipt", "Prints values from the transaction receipt, given a valid tx hash.")
+	fmt.Printf("Transaction:\n%s\n\n", txHash.Hex())
+	fmt.Println("Blocknumber:      ", receipt.BlockNumber)
+	fmt.Println("Contract address: ", receipt.ContractAddress)
+	fmt.Println("Status:           ", receipt.Status)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		fmt.Println("Failure reason:   ", output.FailureReason)
+	}
+	fmt.Println("Gas used:         ", receipt.GasUsed)
+	fmt.Println("Logs:             ", len(receipt.Logs))
+	printLogs(output.Logs)
+	if output.CodeSize != nil {
+		fmt.Println("Length of code at contract address: ", *output.CodeSize)
+	}
+
+	return emit(output)
+}
+
+// failureReason replays the failed transaction to determine, why it
+// failed. Custom errors are decoded with the Maltcoin ABI and the given ABIs.
+func failureReason(client util.DiagnoseBackend, txHash common.Hash, abis []*abi.ABI) string {
+	if maltcoinABI, err := util.GetMaltcoinABI(); err == nil {
+		abis = append([]*abi.ABI{maltcoinABI}, abis...)
+	}
+
+	var failure *util.FailedTransactionError
+	if err := util.DiagnoseTransaction(context.Background(), client, txHash, abis...); errors.As(err, &failure) {
+		return failure.Reason.Error()
+	} else if err != nil {
+		return fmt.Sprintf("unknown (%v)", err)
+	}
+
+	return "unknown"
+}
+
+// logOutputs converts the decoded logs for the output. Token amounts are
+// formatted in the unit of the emitting contract, if it can be queried.
+// Logs, which could not be decoded, contain their raw topics and data.
+func logOutputs(client bind.ContractBackend, logs []*util.DecodedLog, raw bool) []logOutput {
+	units := make(map[common.Address]*util.TokenUnit)
+	outputs := make([]logOutput, 0, len(logs))
+	for _, log := range logs {
+		output := logOutput{Index: log.Log.Index, Address: log.Log.Address}
+		if log.Event == nil {
+			output.Topics = log.Log.Topics
+			output.Data = hexutil.Encode(log.Log.Data)
+			outputs = append(outputs, output)
+			continue
+		}
+
+		output.Event = log.Event.Name
+		output.Fields = make([]fieldOutput, 0, len(log.Fields))
+		for i, field := range log.Fields {
+			name := field.Name
+			if name == "" {
+				name = fmt.Sprint(i)
+			}
+			fo := fieldOutput{Name: name, Type: field.Type.String(), Value: util.FormatValue(field.Value)}
+			if amount, ok := field.Value.(*big.Int); ok && log.IsTokenAmount(field) {
+				unit, known := units[log.Log.Address]
+				if !known {
+					// Logs of contracts without decimals are printed as is
+					if queried, err := tokenUnit(client, log.Log.Address, raw); err == nil {
+						unit = &queried
+					}
+					units[log.Log.Address] = unit
+				}
+				if unit != nil {
+					amountOutput := newAmountOutput(amount, *unit)
+					fo.Amount = &amountOutput
+				}
+			}
+			output.Fields = append(output.Fields, fo)
+		}
+		outputs = append(outputs, output)
+	}
+
+	return outputs
+}
+
+// printLogs prints the decoded logs.
+func printLogs(logs []logOutput) {
+	for _, log := range logs {
+		fmt.Println()
+		if log.Event == "" {
+			fmt.Printf("  #%d unknown event emitted by %s\n", log.Index, log.Address)
+			for i, topic := range log.Topics {
+				fmt.Printf("     topic %d: %s\n", i, topic.Hex())
+			}
+			fmt.Printf("     data:    %s\n", log.Data)
+			continue
+		}
+
+		fmt.Printf("  #%d %s emitted by %s\n", log.Index, log.Event, log.Address)
+		width := 0
+		for _, field := range log.Fields {
+			if len(field.Name) > width {
+				width = len(field.Name)
+			}
+		}
+		for _, field := range log.Fields {
+			value := field.Value
+			if field.Amount != nil {
+				value = field.Amount.String()
+			}
+			fmt.Printf("     %-*s (%s) %s\n", width+1, field.Name+":", field.Type, value)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// signer.go contains the flags, which select the signing key of a
+// subcommand, and the handling of keystore passphrases.
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/term"
+)
+
+const (
+	// EnvAccount is the environment variable, which can hold the address of
+	// the keystore account instead of passing it with the --account flag.
+	EnvAccount = "MALTCOIN_ACCOUNT"
+
+	// EnvPassword is the environment variable, which can hold the passphrase
+	// of the keystore account.
+	EnvPassword = "MALTCOIN_PASSWORD"
+
+	// EnvPrivateKey is the environment variable, which can hold the hex
+	// encoded private key of the signer. It is meant for development
+	// setups only, where no keystore is used.
+	EnvPrivateKey = "MALTCOIN_PRIVATE_KEY"
+)
+
+// signerFlags contains the flags, which select the keystore account
+// used to sign transactions.
+type signerFlags struct {
+	*passwordFlags
+
+	keystore *string
+	account  *string
+}
+
+// passwordFlags contains the flag for the passphrase file of a
+// keystore account.
+type passwordFlags struct {
+	passwordFile *string
+}
+
+// keystoreFlag registers the flag for the keystore directory.
+func (cf *commandFlags) keystoreFlag() *string {
+	return cf.String("keystore", "", "directory of the keystore (env "+util.EnvKeystore+", default \""+util.DefaultKeystoreDir+"\")")
+}
+
+// passwordFlags registers the flag for the passphrase file.
+func (cf *commandFlags) passwordFlags() *passwordFlags {
+	return &passwordFlags{
+		passwordFile: cf.String("password-file", "", "file containing the passphrase of the keystore account (env "+EnvPassword+", default: prompt)"),
+	}
+}
+
+// signerFlags registers the flags to select the signing account.
+func (cf *commandFlags) signerFlags() *signerFlags {
+	return &signerFlags{
+		passwordFlags: cf.passwordFlags(),
+		keystore:      cf.keystoreFlag(),
+		account:       cf.String("account", "", "address of the keystore account, which signs the transaction (env "+EnvAccount+")"),
+	}
+}
+
+// keystoreDir returns the keystore directory from the given flag value,
+// the environment or the default.
+func keystoreDir(value string) string {
+	return firstNonEmpty(value, os.Getenv(util.EnvKeystore), util.DefaultKeystoreDir)
+}
+
+// address returns the address of the selected signer without decrypting
+// its key. The second return value is false, if no signer was selected.
+func (sf *signerFlags) address() (common.Address, bool, error) {
+	account := firstNonEmpty(*sf.account, os.Getenv(EnvAccount))
+	if account != "" {
+		address, err := parseAddress("account", account)
+		return address, err == nil, err
+	}
+
+	if hexKey := os.Getenv(EnvPrivateKey); hexKey != "" {
+		privKey, err := parseHexKey(hexKey)
+		if err != nil {
+			return common.Address{}, false, usageErrorf("%s: %v", EnvPrivateKey, err)
+		}
+		return crypto.PubkeyToAddress(privKey.PublicKey), true, nil
+	}
+
+	return common.Address{}, false, nil
+}
+
+// privateKey decrypts the key of the selected keystore account. If no
+// account is selected, the development key from the environment is used.
+func (sf *signerFlags) privateKey() (*ecdsa.PrivateKey, error) {
+	account := firstNonEmpty(*sf.account, os.Getenv(EnvAccount))
+	if account == "" {
+		if hexKey := os.Getenv(EnvPrivateKey); hexKey != "" {
+			privKey, err := parseHexKey(hexKey)
+			if err != nil {
+				return nil, usageErrorf("%s: %v", EnvPrivateKey, err)
+			}
+			return privKey, nil
+		}
+		return nil, usageErrorf("--account or %s is required", EnvAccount)
+	}
+
+	address, err := parseAddress("account", account)
+	if err != nil {
+		return nil, err
+	}
+	ks := util.OpenKeystore(keystoreDir(*sf.keystore))
+	if _, err := util.FindAccount(ks, address); err != nil {
+		return nil, err
+	}
+
+	passphrase, err := sf.passphrase(fmt.Sprintf("Passphrase of %s: ", address), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.ExportKey(ks, address, passphrase)
+}
+
+// passphrase reads the passphrase from the passphrase file, the environment
+// or, if neither is given, from a terminal prompt. New passphrases have to
+// be confirmed, when they are entered at the prompt.
+func (pf *passwordFlags) passphrase(prompt string, confirm bool) (string, error) {
+	if *pf.passwordFile != "" {
+		bz, err := os.ReadFile(*pf.passwordFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read passphrase: %w", err)
+		}
+		// Only the first line contains the passphrase
+		return strings.TrimRight(string(bytes.SplitN(bz, []byte("\n"), 2)[0]), "\r"), nil
+	}
+	if passphrase, ok := os.LookupEnv(EnvPassword); ok {
+		return passphrase, nil
+	}
+
+	passphrase, err := promptPassphrase(prompt)
+	if err != nil {
+		return "", err
+	}
+	if confirm {
+		repeated, err := promptPassphrase("Repeat passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if repeated != passphrase {
+			return "", errors.New("passphrases do not match")
+		}
+	}
+
+	return passphrase, nil
+}
+
+// promptPassphrase reads a passphrase from the terminal without echoing it.
+// The controlling terminal is used, so that the standard input can be used
+// for other data, e.g. a key to import.
+func promptPassphrase(prompt string) (string, error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		if !term.IsTerminal(int(os.Stdin.Fd())) {
+			return "", fmt.Errorf("no terminal to prompt for the passphrase, use --password-file or %s", EnvPassword)
+		}
+		tty = os.Stdin
+	} else {
+		defer tty.Close()
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	bz, err := term.ReadPassword(int(tty.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase: %w", err)
+	}
+
+	return string(bz), nil
+}
+
+// parseHexKey converts a hex encoded private key.
+func parseHexKey(value string) (*ecdsa.PrivateKey, error) {
+	privKey, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(value), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	return privKey, nil
+}
+
+// firstNonEmpty returns the first of the given values, which is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,156 @@
+// snapshot.go contains the snapshot subcommand, which exports the token
+// balances of all holders at a given block.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Export formats of the snapshot
+const (
+	formatCSV  = "csv"
+	formatJSON = "json"
+)
+
+// snapshotOutput is the structured output of the snapshot subcommand. The
+// holders are only contained in the written file.
+type snapshotOutput struct {
+	Contract    common.Address `json:"contract"`
+	BlockNumber uint64         `json:"block_number"`
+	StartBlock  uint64         `json:"start_block"`
+	Holders     int            `json:"holders"`
+	TotalSupply amountOutput   `json:"total_supply"`
+	File        string         `json:"file"`
+	Format      string         `json:"format"`
+}
+
+// runSnapshot finds all holders of the token by scanning its Transfer logs
+// and exports their balances at the given block to a CSV or JSON file.
+func runSnapshot(args []string) error {
+	cf := newCommandFlags("snapshot", "Export the token balances of all holders at a block to a CSV or JSON file. "+
+		"The holders are found by scanning the Transfer logs up to the block and the sum of their balances "+
+		"is checked against the total supply at the block. Past blocks require an archive node.")
+	contractHex := cf.addressFlag("contract", "address of the token contract")
+	block := cf.Uint64("block", 0, "block of the snapshot (default: latest block)")
+	fromBlock := cf.Uint64("from-block", 0, "first block to scan for transfers")
+	deployTxHex := cf.String("deploy-tx", "", "hash of the deployment transaction to start scanning at its block")
+	batchSize := cf.Uint64("batch-size", util.DefaultIndexerOptions().BatchSize, "maximum number of blocks to query at once")
+	format := cf.String("format", formatCSV, "export format, csv or json")
+	out := cf.String("out", "", "file to write the snapshot to (default: snapshot-<block>.<format>)")
+	raw := cf.Bool("raw", false, "write the balances of the CSV file in the smallest unit instead of whole tokens")
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	if *format != formatCSV && *format != formatJSON {
+		return usageErrorf("--format: unknown format %q, must be %s or %s", *format, formatCSV, formatJSON)
+	}
+	if *batchSize == 0 {
+		return usageErrorf("--batch-size must be positive")
+	}
+	var deployTx common.Hash
+	if *deployTxHex != "" {
+		if cf.isSet("from-block") {
+			return usageErrorf("--from-block and --deploy-tx are mutually exclusive")
+		}
+		if deployTx, err = parseTxHash("deploy-tx", *deployTxHex); err != nil {
+			return err
+		}
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	client, err := util.GetClient(profile)
+	if err != nil {
+		return fmt.Errorf("failed to connect to node: %w", err)
+	}
+	defer client.Close()
+
+	startBlock := *fromBlock
+	if *deployTxHex != "" {
+		if startBlock, err = deploymentBlock(client, deployTx, contractAddress); err != nil {
+			return err
+		}
+	}
+	blockNumber := *block
+	if !cf.isSet("block") {
+		if blockNumber, err = client.BlockNumber(context.Background()); err != nil {
+			return fmt.Errorf("failed to get latest block: %w", err)
+		}
+	}
+	if *out == "" {
+		*out = fmt.Sprintf("snapshot-%d.%s", blockNumber, *format)
+	}
+
+	printHeader("maltcoin snapshot", fmt.Sprintf("Exports the balances of all token holders on the %q network.", profile.Name))
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Snapshot block:   ", blockNumber)
+	fmt.Println("Start block:      ", startBlock)
+	fmt.Println()
+
+	snapshot, err := util.TakeSnapshot(context.Background(), client, contractAddress, blockNumber, util.SnapshotOptions{
+		StartBlock: startBlock,
+		BatchSize:  *batchSize,
+		OnProgress: func(block, target uint64) {
+			fmt.Printf("Scanned transfers up to block %d of %d\n", block, target)
+		},
+	})
+	if errors.Is(err, util.ErrSnapshotMismatch) {
+		return fmt.Errorf("snapshot is incomplete, check the start block: %w", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := writeSnapshot(*out, *format, snapshot, *raw); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	fmt.Println()
+	fmt.Println("Holders:          ", len(snapshot.Holders))
+	fmt.Println("Total supply:     ", util.NewTokenAmount(snapshot.TotalSupply, snapshot.Unit(*raw)))
+	fmt.Println("Written to:       ", *out)
+
+	return emit(snapshotOutput{
+		Contract:    contractAddress,
+		BlockNumber: blockNumber,
+		StartBlock:  startBlock,
+		Holders:     len(snapshot.Holders),
+		TotalSupply: newAmountOutput(snapshot.TotalSupply, snapshot.Unit(*raw)),
+		File:        *out,
+		Format:      *format,
+	})
+}
+
+// writeSnapshot writes the snapshot in the given format to the file. The
+// JSON format always contains the balances in the smallest unit together
+// with the decimals of the token.
+func writeSnapshot(path, format string, snapshot *util.Snapshot, raw bool) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if format == formatJSON {
+		err = util.WriteSnapshotJSON(f, snapshot)
+	} else {
+		err = util.WriteSnapshotCSV(f, snapshot, raw)
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,414 @@
+// transfer.go contains the subcommands, which send transactions to move
+// or approve tokens of a token contract.
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// transactionFlags contains the flags, that every subcommand sending
+// a token transaction accepts.
+type transactionFlags struct {
+	*commandFlags
+
+	contract *string
+	raw      *bool
+	dryRun   *bool
+	signer   *signerFlags
+	wait     *waitFlags
+}
+
+// newTransactionFlags creates the flag set for a subcommand, which sends
+// a transaction to the token contract.
+func newTransactionFlags(name, description string) *transactionFlags {
+	cf := newCommandFlags(name, description)
+
+	return &transactionFlags{
+		commandFlags: cf,
+		contract:     cf.addressFlag("contract", "address of the token contract"),
+		raw:          cf.rawFlag(),
+		dryRun:       cf.dryRunFlag(),
+		signer:       cf.signerFlags(),
+		wait:         cf.waitFlags(),
+	}
+}
+
+// tokenTransaction contains everything, that is needed to send a
+// transaction to the token contract.
+type tokenTransaction struct {
+	client          *ethclient.Client
+	contract        *maltcoin.Maltcoin
+	contractAddress common.Address
+	auth            *bind.TransactOpts
+	unit            util.TokenUnit
+	network         string
+	feeOptions      util.FeeOptions
+	waitOptions     util.WaitOptions
+}
+
+// parseSigner validates the contract address and decrypts the key of
+// the signer.
+func (tf *transactionFlags) parseSigner() (common.Address, *ecdsa.PrivateKey, error) {
+	contractAddress, err := parseAddress("contract", *tf.contract)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	privKey, err := tf.signer.privateKey()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return contractAddress, privKey, nil
+}
+
+// connect selects the network profile, connects to the node and loads the
+// token contract together with the unit of its amounts.
+func (tf *transactionFlags) connect(contractAddress common.Address, privKey *ecdsa.PrivateKey) (*tokenTransaction, util.NetworkProfile, error) {
+	profile, err := tf.profile()
+	if err != nil {
+		return nil, util.NetworkProfile{}, err
+	}
+	waitOptions, err := tf.wait.options(profile)
+	if err != nil {
+		return nil, util.NetworkProfile{}, err
+	}
+
+	client, auth, err := util.GetClientAndTransactionSigner(profile, privKey)
+	if err != nil {
+		return nil, util.NetworkProfile{}, fmt.Errorf("error while connecting to the node and getting the transaction signer: %w", err)
+	}
+
+	contract, err := util.GetContract(client, contractAddress)
+	if err != nil {
+		client.Close()
+		return nil, util.NetworkProfile{}, fmt.Errorf("failed to load token contract: %w", err)
+	}
+
+	unit, err := tokenUnit(client, contractAddress, *tf.raw)
+	if err != nil {
+		client.Close()
+		return nil, util.NetworkProfile{}, err
+	}
+
+	return &tokenTransaction{
+		client:          client,
+		contract:        contract,
+		contractAddress: contractAddress,
+		auth:            auth,
+		unit:            unit,
+		network:         profile.Name,
+		feeOptions:      profile.FeeOptions(),
+		waitOptions:     waitOptions,
+	}, profile, nil
+}
+
+// prepare fills the transaction signer fields for calling the given
+// contract method with the given arguments.
+func (tt *tokenTransaction) prepare(method string, args ...interface{}) error {
+	// Get the necessary call data byte array, that contains the
+	// method name and its arguments.
+	callData, err := util.GetCallData(method, args...)
+	if err != nil {
+		return fmt.Errorf("error while getting the call data: %w", err)
+	}
+
+	// Using the data in the call message struct, the transaction signer
+	// can be configured for the transaction.
+	callMsg := ethereum.CallMsg{
+		From: tt.auth.From,
+		To:   &tt.contractAddress,
+		Data: callData,
+	}
+	tt.auth, err = util.FillTransactionSignerFieldsWithFees(tt.auth, tt.client, callMsg, tt.feeOptions)
+	if err != nil {
+		return fmt.Errorf("error while filling the transaction signer fields: %w", err)
+	}
+
+	return nil
+}
+
+// dryRun prints the estimate of the prepared transaction to the token
+// contract instead of sending it.
+func (tt *tokenTransaction) dryRun() error {
+	estimate, err := util.EstimateTransaction(context.Background(), tt.client, tt.auth)
+	if err != nil {
+		return err
+	}
+
+	return dryRun(tt.auth, estimate, estimateOutput{
+		Network:  tt.network,
+		To:       &tt.contractAddress,
+		Contract: tt.contractAddress,
+	}, tt.unit.Raw)
+}
+
+// amount formats an amount in the unit of the token.
+func (tt *tokenTransaction) amount(value *big.Int) util.TokenAmount {
+	return util.NewTokenAmount(value, tt.unit)
+}
+
+// transferOutput is the structured output of the transfer and transfer-from
+// subcommands. The balances of the sender and the recipient are given
+// before and after the transfer.
+type transferOutput struct {
+	Contract       common.Address    `json:"contract"`
+	From           common.Address    `json:"from"`
+	To             common.Address    `json:"to"`
+	Amount         amountOutput      `json:"amount"`
+	Transaction    transactionOutput `json:"transaction"`
+	BalancesBefore []balanceOutput   `json:"balances_before"`
+	BalancesAfter  []balanceOutput   `json:"balances_after"`
+}
+
+// approveOutput is the structured output of the approve subcommand.
+type approveOutput struct {
+	Contract    common.Address    `json:"contract"`
+	Owner       common.Address    `json:"owner"`
+	Spender     common.Address    `json:"spender"`
+	Allowance   amountOutput      `json:"allowance"`
+	Transaction transactionOutput `json:"transaction"`
+}
+
+// printBalances queries and prints the token balances of the given
+// addresses.
+func printBalances(contract *maltcoin.Maltcoin, unit util.TokenUnit, title string, addresses ...common.Address) ([]balanceOutput, error) {
+	fmt.Printf("\n%s (in %v):\n", title, unit.Name())
+	fmt.Printf("                  ADDRESS                    |               BALANCE           \n")
+	fmt.Printf("---------------------------------------------|----------------------------------\n")
+	balances := make([]balanceOutput, 0, len(addresses))
+	for _, address := range addresses {
+		balance, err := contract.BalanceOf(nil, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve balance: %w", err)
+		}
+		fmt.Printf("%v   | %v\n", address, util.NewTokenAmount(balance, unit).Number())
+		balances = append(balances, balanceOutput{Address: address, Balance: newAmountOutput(balance, unit)})
+	}
+	fmt.Println()
+
+	return balances, nil
+}
+
+// runTransfer transfers tokens from the signer to a recipient.
+func runTransfer(args []string) error {
+	tf := newTransactionFlags("transfer", "Transfer tokens from the signer to a recipient and print the balances before and after the transfer.")
+	recipientHex := tf.addressFlag("to", "address of the recipient")
+	amountStr := tf.String("amount", "", "amount of tokens, e.g. 1.5 or 1.5MALT (required)")
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	recipient, err := parseAddress("to", *recipientHex)
+	if err != nil {
+		return err
+	}
+	if err := requireAmount("amount", *amountStr); err != nil {
+		return err
+	}
+	tt, profile, err := tf.connect(contractAddress, privKey)
+	if err != nil {
+		return err
+	}
+	defer tt.client.Close()
+
+	amount, err := parseAmount("amount", *amountStr, tt.unit)
+	if err != nil {
+		return err
+	}
+	if err := tt.prepare("transfer", recipient, amount); err != nil {
+		return err
+	}
+
+	printHeader("maltcoin transfer", fmt.Sprintf("Transfers tokens between users of a Maltcoin contract on the %q network.", profile.Name))
+	if *tf.dryRun {
+		fmt.Printf("Transfer of %v to %s\n", tt.amount(amount), recipient)
+		return tt.dryRun()
+	}
+	fmt.Println("Maltcoin contract loaded at address: ", contractAddress)
+	fmt.Println("Fee mode:                            ", util.DescribeFees(tt.auth))
+	before, err := printBalances(tt.contract, tt.unit, "Account balances pre transaction", tt.auth.From, recipient)
+	if err != nil {
+		return err
+	}
+
+	// Transfer tokens from signer address to recipient address
+	tx, err := tt.contract.Transfer(tt.auth, recipient, amount)
+	if err != nil {
+		return fmt.Errorf("failed to transfer tokens: %w", err)
+	}
+	fmt.Printf("Transferring %v in tx %v\n", tt.amount(amount), tx.Hash().Hex())
+
+	return tt.finishTransfer(tx, tt.auth.From, recipient, amount, before)
+}
+
+// finishTransfer waits for the transfer of the given amount and prints the
+// balances of the sender and the recipient afterwards.
+func (tt *tokenTransaction) finishTransfer(tx *types.Transaction, from, to common.Address, amount *big.Int, before []balanceOutput) error {
+	receipt, err := waitForTransaction(tt.client, tx, tt.waitOptions)
+	if err != nil {
+		return err
+	}
+
+	after, err := printBalances(tt.contract, tt.unit, "Account balances post transaction", from, to)
+	if err != nil {
+		return err
+	}
+
+	return emit(transferOutput{
+		Contract:       tt.contractAddress,
+		From:           from,
+		To:             to,
+		Amount:         newAmountOutput(amount, tt.unit),
+		Transaction:    newTransactionOutput(tx, tt.auth.From, receipt),
+		BalancesBefore: before,
+		BalancesAfter:  after,
+	})
+}
+
+// runApprove approves a spender to transfer tokens on behalf of the signer.
+func runApprove(args []string) error {
+	tf := newTransactionFlags("approve", "Approve a spender to transfer up to the given amount of tokens on behalf of the signer.")
+	spenderHex := tf.addressFlag("spender", "address, which is allowed to spend the tokens")
+	amountStr := tf.String("amount", "", "amount of tokens, e.g. 1.5 or 1.5MALT (required)")
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	spender, err := parseAddress("spender", *spenderHex)
+	if err != nil {
+		return err
+	}
+	if err := requireAmount("amount", *amountStr); err != nil {
+		return err
+	}
+	tt, profile, err := tf.connect(contractAddress, privKey)
+	if err != nil {
+		return err
+	}
+	defer tt.client.Close()
+
+	amount, err := parseAmount("amount", *amountStr, tt.unit)
+	if err != nil {
+		return err
+	}
+	if err := tt.prepare("approve", spender, amount); err != nil {
+		return err
+	}
+	if *tf.dryRun {
+		printHeader("maltcoin approve", fmt.Sprintf("Approves a spender on a Maltcoin contract on the %q network.", profile.Name))
+		fmt.Printf("Approval of %v for %s\n", tt.amount(amount), spender)
+		return tt.dryRun()
+	}
+
+	tx, err := tt.contract.Approve(tt.auth, spender, amount)
+	if err != nil {
+		return fmt.Errorf("failed to approve tokens: %w", err)
+	}
+
+	receipt, err := waitForTransaction(tt.client, tx, tt.waitOptions)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := tt.contract.Allowance(nil, tt.auth.From, spender)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve allowance: %w", err)
+	}
+
+	printHeader("maltcoin approve", fmt.Sprintf("Approves a spender on a Maltcoin contract on the %q network.", profile.Name))
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Owner:            ", tt.auth.From)
+	fmt.Println("Spender:          ", spender)
+	fmt.Println("Transaction:      ", tx.Hash().Hex())
+	fmt.Println("Fee mode:         ", util.DescribeFees(tt.auth))
+	fmt.Println("Allowance:        ", tt.amount(allowance))
+
+	return emit(approveOutput{
+		Contract:    contractAddress,
+		Owner:       tt.auth.From,
+		Spender:     spender,
+		Allowance:   newAmountOutput(allowance, tt.unit),
+		Transaction: newTransactionOutput(tx, tt.auth.From, receipt),
+	})
+}
+
+// runTransferFrom transfers tokens on behalf of an owner, who approved
+// the signer to spend them.
+func runTransferFrom(args []string) error {
+	tf := newTransactionFlags("transfer-from", "Transfer tokens on behalf of an owner, who approved the signer to spend them.")
+	ownerHex := tf.addressFlag("from", "address of the owner of the tokens")
+	recipientHex := tf.addressFlag("to", "address of the recipient")
+	amountStr := tf.String("amount", "", "amount of tokens, e.g. 1.5 or 1.5MALT (required)")
+	if err := tf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, privKey, err := tf.parseSigner()
+	if err != nil {
+		return err
+	}
+	owner, err := parseAddress("from", *ownerHex)
+	if err != nil {
+		return err
+	}
+	recipient, err := parseAddress("to", *recipientHex)
+	if err != nil {
+		return err
+	}
+	if err := requireAmount("amount", *amountStr); err != nil {
+		return err
+	}
+	tt, profile, err := tf.connect(contractAddress, privKey)
+	if err != nil {
+		return err
+	}
+	defer tt.client.Close()
+
+	amount, err := parseAmount("amount", *amountStr, tt.unit)
+	if err != nil {
+		return err
+	}
+	if err := tt.prepare("transferFrom", owner, recipient, amount); err != nil {
+		return err
+	}
+
+	printHeader("maltcoin transfer-from", fmt.Sprintf("Transfers approved tokens on a Maltcoin contract on the %q network.", profile.Name))
+	if *tf.dryRun {
+		fmt.Printf("Transfer of %v from %s to %s\n", tt.amount(amount), owner, recipient)
+		return tt.dryRun()
+	}
+	fmt.Println("Maltcoin contract loaded at address: ", contractAddress)
+	fmt.Println("Spender:                             ", tt.auth.From)
+	fmt.Println("Fee mode:                            ", util.DescribeFees(tt.auth))
+	before, err := printBalances(tt.contract, tt.unit, "Account balances pre transaction", owner, recipient)
+	if err != nil {
+		return err
+	}
+
+	tx, err := tt.contract.TransferFrom(tt.auth, owner, recipient, amount)
+	if err != nil {
+		return fmt.Errorf("failed to transfer tokens: %w", err)
+	}
+	fmt.Printf("Transferring %v in tx %v\n", tt.amount(amount), tx.Hash().Hex())
+
+	return tt.finishTransfer(tx, owner, recipient, amount, before)
+}
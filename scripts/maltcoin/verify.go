@@ -0,0 +1,132 @@
+// verify.go contains the verify subcommand, which compares the code
+// deployed at an address with the build artifacts of the token contract.
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// verifyOutput is the structured output of the verify subcommand. The
+// offset and the differing bytes are only set for a mismatch.
+type verifyOutput struct {
+	Contract      common.Address `json:"contract"`
+	Artifact      string         `json:"artifact"`
+	Status        string         `json:"status"`
+	ExpectedSize  int            `json:"expected_size"`
+	CodeSize      int            `json:"code_size"`
+	MetadataMatch bool           `json:"metadata_match"`
+	Immutables    int            `json:"immutables"`
+	Offset        *int           `json:"offset"`
+	Expected      hexutil.Bytes  `json:"expected,omitempty"`
+	Actual        hexutil.Bytes  `json:"actual,omitempty"`
+}
+
+// runVerify compares the code at an address with the runtime bytecode of
+// the token contract.
+func runVerify(args []string) error {
+	cf := newCommandFlags("verify", "Verify, that the code deployed at an address matches the Maltcoin build artifacts. "+
+		"The expected runtime bytecode is computed by executing the constructor of the artifact. The metadata hash, "+
+		"which solc appends, and the values of immutable variables are ignored. The token flags have to match the "+
+		"constructor arguments of the deployment, because the name is stored in an immutable variable.")
+	contractHex := cf.addressFlag("contract", "address of the deployed contract")
+	artifact := cf.artifactFlags("verify against")
+	token := cf.tokenFlags(false)
+	raw := cf.rawFlag()
+	if err := cf.parse(args); err != nil {
+		return err
+	}
+
+	contractAddress, err := parseAddress("contract", *contractHex)
+	if err != nil {
+		return err
+	}
+	if err := artifact.validate(); err != nil {
+		return err
+	}
+	settings, err := token.settings(*raw)
+	if err != nil {
+		return err
+	}
+	profile, err := cf.profile()
+	if err != nil {
+		return err
+	}
+
+	loaded, err := artifact.load(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to load artifact: %w", err)
+	}
+	expected, err := loaded.RuntimeCode(constructorArgs(loaded, settings)...)
+	if err != nil {
+		return err
+	}
+
+	client, err := util.GetClient(profile)
+	if err != nil {
+		return fmt.Errorf("failed to connect to node: %w", err)
+	}
+	defer client.Close()
+
+	verification, err := util.VerifyCode(context.Background(), client, contractAddress, expected)
+	if err != nil {
+		return err
+	}
+
+	printHeader("maltcoin verify", "Compares the deployed code with the Maltcoin build artifacts.")
+	fmt.Println("Contract address: ", contractAddress)
+	fmt.Println("Artifact:         ", artifact.kind())
+	fmt.Println("Expected size:    ", verification.ExpectedSize)
+	fmt.Println("Code size:        ", verification.ActualSize)
+	fmt.Println("Immutables:       ", len(expected.Immutables))
+	switch verification.Status {
+	case util.CodeMatch:
+		fmt.Println("Result:            code matches the artifact")
+		if !verification.MetadataMatch {
+			fmt.Println("                   (the metadata hash differs)")
+		}
+	case util.NotAContract:
+		fmt.Println("Result:            not a contract")
+	default:
+		fmt.Println("Result:           ", "code differs from byte", verification.Offset)
+		fmt.Println("Expected bytes:   ", hexutil.Encode(verification.Expected))
+		fmt.Println("Actual bytes:     ", hexutil.Encode(verification.Actual))
+	}
+
+	output := verifyOutput{
+		Contract:      contractAddress,
+		Artifact:      artifact.kind(),
+		Status:        verification.Status,
+		ExpectedSize:  verification.ExpectedSize,
+		CodeSize:      verification.ActualSize,
+		MetadataMatch: verification.MetadataMatch,
+		Immutables:    len(expected.Immutables),
+	}
+	if verification.Status == util.CodeMismatch {
+		output.Offset = &verification.Offset
+		output.Expected = verification.Expected
+		output.Actual = verification.Actual
+	}
+	if err := emit(output); err != nil {
+		return err
+	}
+
+	return verification.Err()
+}
+
+// constructorArgs returns the arguments, with which the constructor of the
+// artifact is executed to get the runtime bytecode. The hash of the token
+// name is stored in an immutable variable for the EIP-712 domain, so that
+// the runtime bytecode depends on the given settings. Artifacts of contracts
+// without constructor parameters are executed without arguments.
+func constructorArgs(artifact *util.Artifact, settings util.TokenSettings) []interface{} {
+	if len(artifact.ABI.Constructor.Inputs) == 0 {
+		return nil
+	}
+
+	return settings.Args()
+}
@@ -0,0 +1,345 @@
+// abicall.go contains a generic facility to call any method of a contract
+// ABI. The method arguments are parsed from strings, e.g. given on the
+// command line, into the corresponding ABI types and the return values are
+// decoded according to the method outputs.
+package util
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/address"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// GetMaltcoinABI returns the ABI of the Maltcoin token contract.
+func GetMaltcoinABI() (*abi.ABI, error) {
+	return maltcoin.MaltcoinMetaData.GetAbi()
+}
+
+// LoadABI reads a contract ABI from the given JSON file. Both the plain
+// ABI as generated by solc and build artifacts, which contain the ABI
+// in an "abi" field, are supported.
+func LoadABI(path string) (*abi.ABI, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unwrap the ABI from build artifacts
+	var artifact struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	if err := json.Unmarshal(bz, &artifact); err == nil && len(artifact.ABI) > 0 {
+		bz = artifact.ABI
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(string(bz)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ABI in %s: %w", path, err)
+	}
+
+	return &contractABI, nil
+}
+
+// GetMethod returns the method with the given name from the ABI.
+func GetMethod(contractABI *abi.ABI, name string) (abi.Method, error) {
+	method, ok := contractABI.Methods[name]
+	if !ok {
+		return abi.Method{}, fmt.Errorf("method %q not found in ABI", name)
+	}
+
+	return method, nil
+}
+
+// ParseArgs converts the given string values into the types of the given
+// ABI arguments, so that they can be packed into call data. Addresses are
+// given as hex or as bech32 strings with the given human-readable part.
+func ParseArgs(arguments abi.Arguments, values []string, hrp string) ([]interface{}, error) {
+	if len(arguments) != len(values) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(arguments), len(values))
+	}
+
+	args := make([]interface{}, len(arguments))
+	for i, argument := range arguments {
+		arg, err := ParseArg(argument.Type, values[i], hrp)
+		if err != nil {
+			name := argument.Name
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			return nil, fmt.Errorf("argument %s (%s): %w", name, argument.Type, err)
+		}
+		args[i] = arg
+	}
+
+	return args, nil
+}
+
+// ParseArg converts a string value into the Go type, which corresponds to
+// the given ABI type. Arrays and slices are given as comma-separated lists
+// in square brackets, e.g. "[1,2,3]".
+func ParseArg(typ abi.Type, value, hrp string) (interface{}, error) {
+	v, err := parseValue(typ, strings.TrimSpace(value), hrp)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.Interface(), nil
+}
+
+// parseValue converts a string into a reflect value of the given ABI type.
+func parseValue(typ abi.Type, value, hrp string) (reflect.Value, error) {
+	goType := typ.GetType()
+
+	switch typ.T {
+	case abi.AddressTy:
+		addr, err := address.Parse(value, hrp)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(addr), nil
+
+	case abi.BoolTy:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid bool %q", value)
+		}
+		return reflect.ValueOf(b), nil
+
+	case abi.StringTy:
+		return reflect.ValueOf(value), nil
+
+	case abi.IntTy, abi.UintTy:
+		return parseInteger(typ, value)
+
+	case abi.BytesTy:
+		bz, err := decodeHex(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(bz), nil
+
+	case abi.FixedBytesTy:
+		bz, err := decodeHex(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(bz) != typ.Size {
+			return reflect.Value{}, fmt.Errorf("expected %d bytes, got %d", typ.Size, len(bz))
+		}
+		arr := reflect.New(goType).Elem()
+		reflect.Copy(arr, reflect.ValueOf(bz))
+		return arr, nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		elements, err := splitList(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		var list reflect.Value
+		if typ.T == abi.SliceTy {
+			list = reflect.MakeSlice(goType, len(elements), len(elements))
+		} else {
+			if len(elements) != typ.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d elements, got %d", typ.Size, len(elements))
+			}
+			list = reflect.New(goType).Elem()
+		}
+
+		for i, element := range elements {
+			v, err := parseValue(*typ.Elem, element, hrp)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("element %d: %w", i, err)
+			}
+			list.Index(i).Set(v)
+		}
+		return list, nil
+
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported argument type %s", typ)
+	}
+}
+
+// parseInteger converts a decimal or 0x-prefixed hex string into a
+// signed or unsigned integer of the given ABI type. The value must fit
+// into the bit size of the type.
+func parseInteger(typ abi.Type, value string) (reflect.Value, error) {
+	n, ok := new(big.Int).SetString(value, 0)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("invalid integer %q", value)
+	}
+
+	if typ.T == abi.UintTy {
+		if n.Sign() < 0 || n.BitLen() > typ.Size {
+			return reflect.Value{}, fmt.Errorf("%s out of range for %s", value, typ)
+		}
+	} else {
+		bound := new(big.Int).Lsh(big.NewInt(1), uint(typ.Size-1))
+		if n.Cmp(bound) >= 0 || n.Cmp(new(big.Int).Neg(bound)) < 0 {
+			return reflect.Value{}, fmt.Errorf("%s out of range for %s", value, typ)
+		}
+	}
+
+	goType := typ.GetType()
+	switch goType.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.ValueOf(n.Uint64()).Convert(goType), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(n.Int64()).Convert(goType), nil
+	default:
+		return reflect.ValueOf(n), nil
+	}
+}
+
+// decodeHex decodes a hex string with optional 0x prefix.
+func decodeHex(value string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(value, "0x"), "0X"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex %q", value)
+	}
+
+	return bz, nil
+}
+
+// splitList splits a list in square brackets into its top-level elements.
+// Nested lists are kept intact, so that they can be parsed recursively.
+func splitList(value string) ([]string, error) {
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return nil, fmt.Errorf("invalid list %q, expected [a,b,...]", value)
+	}
+
+	inner := strings.TrimSpace(value[1 : len(value)-1])
+	if inner == "" {
+		return []string{}, nil
+	}
+
+	var (
+		elements []string
+		depth    int
+		start    int
+	)
+	for i, c := range inner {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced brackets in %q", value)
+			}
+		case ',':
+			if depth == 0 {
+				elements = append(elements, strings.TrimSpace(inner[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets in %q", value)
+	}
+
+	return append(elements, strings.TrimSpace(inner[start:])), nil
+}
+
+// CallMethod executes the given contract method as a read-only call at the
+// given block number, which can be nil for the latest block. The return
+// values are decoded according to the method outputs.
+func CallMethod(ctx context.Context, backend bind.ContractCaller, contractABI *abi.ABI, contractAddress, from common.Address, method string, args []interface{}, blockNumber *big.Int) ([]interface{}, error) {
+	callData, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	callMsg := ethereum.CallMsg{
+		From: from,
+		To:   &contractAddress,
+		Data: callData,
+	}
+	output, err := backend.CallContract(ctx, callMsg, blockNumber)
+	if err != nil {
+		return nil, DecodeError(err, contractABI)
+	}
+
+	return contractABI.Unpack(method, output)
+}
+
+// TransactMethod sends a signed transaction, which calls the given contract
+// method. Transaction signer fields, which are not set, are filled by the
+// contract binding. If the gas estimation fails, because the call reverts,
+// the decoded revert is returned.
+func TransactMethod(auth *bind.TransactOpts, backend bind.ContractBackend, contractABI *abi.ABI, contractAddress common.Address, method string, args []interface{}) (*types.Transaction, error) {
+	contract := bind.NewBoundContract(contractAddress, *contractABI, backend, backend, backend)
+
+	tx, err := contract.Transact(auth, method, args...)
+	if err != nil {
+		// The binding does not wrap the error of the gas estimation, so
+		// that the call is replayed to get the revert data.
+		callData, packErr := contractABI.Pack(method, args...)
+		if packErr != nil {
+			return nil, err
+		}
+		msg := ethereum.CallMsg{From: auth.From, To: &contractAddress, Value: auth.Value, Data: callData}
+		return nil, DiagnoseCall(ensureContext(auth.Context), backend, msg, nil, err, contractABI)
+	}
+
+	return tx, nil
+}
+
+// ensureContext returns the given context or, if it is nil, the background
+// context.
+func ensureContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+
+	return ctx
+}
+
+// FormatValue returns a human-readable representation of a value,
+// which was decoded from contract call output.
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case common.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return "0x" + hex.EncodeToString(v)
+	case string:
+		return strconv.Quote(v)
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Array:
+		// Fixed size byte arrays are printed as hex
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(bz), rv)
+			return "0x" + hex.EncodeToString(bz)
+		}
+		fallthrough
+	case reflect.Slice:
+		elements := make([]string, rv.Len())
+		for i := range elements {
+			elements[i] = FormatValue(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
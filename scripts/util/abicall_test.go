@@ -0,0 +1,311 @@
+// abicall_test.go contains the unit tests for the generic contract method
+// caller.
+package util
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/address"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// mustNewType returns the ABI type for the given type string.
+func mustNewType(t *testing.T, typ string) abi.Type {
+	abiType, err := abi.NewType(typ, "", nil)
+	require.NoError(t, err, "Error creating ABI type")
+
+	return abiType
+}
+
+// TestParseArg tests the conversion of strings into the Go
+// representations of different ABI types.
+func TestParseArg(t *testing.T) {
+	testAddress := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	testcases := []struct {
+		name     string
+		expErr   bool
+		typ      string
+		value    string
+		expected interface{}
+	}{
+		{
+			"passes - address",
+			false,
+			"address",
+			"0x1234567890123456789012345678901234567890",
+			testAddress,
+		},
+		{
+			"passes - bech32 address",
+			false,
+			"address",
+			"evmos1zg69v7yszg69v7yszg69v7yszg69v7ysh6sy6u",
+			testAddress,
+		},
+		{
+			"passes - uint256 decimal",
+			false,
+			"uint256",
+			"10000000000000000000000",
+			new(big.Int).Mul(big.NewInt(10000), Ten18),
+		},
+		{
+			"passes - uint256 hex",
+			false,
+			"uint256",
+			"0xff",
+			big.NewInt(255),
+		},
+		{
+			"passes - uint8",
+			false,
+			"uint8",
+			"18",
+			uint8(18),
+		},
+		{
+			"passes - negative int16",
+			false,
+			"int16",
+			"-300",
+			int16(-300),
+		},
+		{
+			"passes - bool",
+			false,
+			"bool",
+			"true",
+			true,
+		},
+		{
+			"passes - string",
+			false,
+			"string",
+			"Maltcoin",
+			"Maltcoin",
+		},
+		{
+			"passes - bytes",
+			false,
+			"bytes",
+			"0xdeadbeef",
+			[]byte{0xde, 0xad, 0xbe, 0xef},
+		},
+		{
+			"passes - bytes4",
+			false,
+			"bytes4",
+			"0xa9059cbb",
+			[4]byte{0xa9, 0x05, 0x9c, 0xbb},
+		},
+		{
+			"passes - address slice",
+			false,
+			"address[]",
+			"[0x1234567890123456789012345678901234567890, 0x1234567890123456789012345678901234567890]",
+			[]common.Address{testAddress, testAddress},
+		},
+		{
+			"passes - empty slice",
+			false,
+			"uint256[]",
+			"[]",
+			[]*big.Int{},
+		},
+		{
+			"passes - nested array",
+			false,
+			"uint8[2][]",
+			"[[1,2],[3,4]]",
+			[][2]uint8{{1, 2}, {3, 4}},
+		},
+		{
+			"fails - invalid address",
+			true,
+			"address",
+			"0x1234",
+			nil,
+		},
+		{
+			"fails - bech32 address with wrong prefix",
+			true,
+			"address",
+			"cosmos1zg69v7yszg69v7yszg69v7yszg69v7ysh6sy6u",
+			nil,
+		},
+		{
+			"fails - uint8 overflow",
+			true,
+			"uint8",
+			"256",
+			nil,
+		},
+		{
+			"fails - negative uint256",
+			true,
+			"uint256",
+			"-1",
+			nil,
+		},
+		{
+			"fails - int8 underflow",
+			true,
+			"int8",
+			"-129",
+			nil,
+		},
+		{
+			"fails - invalid bool",
+			true,
+			"bool",
+			"yes",
+			nil,
+		},
+		{
+			"fails - wrong length of fixed bytes",
+			true,
+			"bytes4",
+			"0xa9059c",
+			nil,
+		},
+		{
+			"fails - wrong length of fixed array",
+			true,
+			"uint256[2]",
+			"[1,2,3]",
+			nil,
+		},
+		{
+			"fails - unbalanced brackets",
+			true,
+			"uint8[][]",
+			"[[1,2],[3]",
+			nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := ParseArg(mustNewType(t, tc.typ), tc.value, address.DefaultHRP)
+			if tc.expErr {
+				require.Error(t, err, "Invalid value should raise an error")
+			} else {
+				require.NoError(t, err, "Error parsing value")
+				require.Equal(t, tc.expected, value, "Wrong parsed value")
+			}
+		})
+	}
+}
+
+// TestLoadABI tests loading a plain ABI file as well as a build artifact,
+// which contains the ABI.
+func TestLoadABI(t *testing.T) {
+	dir := t.TempDir()
+	plainPath := filepath.Join(dir, "Maltcoin.abi")
+	artifactPath := filepath.Join(dir, "Maltcoin.json")
+	invalidPath := filepath.Join(dir, "invalid.abi")
+
+	require.NoError(t, os.WriteFile(plainPath, []byte(maltcoin.MaltcoinMetaData.ABI), 0o600))
+	require.NoError(t, os.WriteFile(artifactPath, []byte(`{"abi": `+maltcoin.MaltcoinMetaData.ABI+`}`), 0o600))
+	require.NoError(t, os.WriteFile(invalidPath, []byte(`[{"type": "function", "inputs": 1}]`), 0o600))
+
+	testcases := []struct {
+		name   string
+		expErr bool
+		path   string
+	}{
+		{"passes - plain ABI", false, plainPath},
+		{"passes - build artifact", false, artifactPath},
+		{"fails - invalid ABI", true, invalidPath},
+		{"fails - missing file", true, filepath.Join(dir, "missing.abi")},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			contractABI, err := LoadABI(tc.path)
+			if tc.expErr {
+				require.Error(t, err, "Loading the ABI should raise an error")
+			} else {
+				require.NoError(t, err, "Error loading ABI")
+				_, err = GetMethod(contractABI, "transferFrom")
+				require.NoError(t, err, "ABI should contain transferFrom")
+			}
+		})
+	}
+}
+
+// TestCallAndTransactMethod tests calling constant methods and sending
+// transactions with arguments parsed from strings on a simulated backend.
+func TestCallAndTransactMethod(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client and transaction signer")
+
+	contractAddress, _, _, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	contractABI, err := GetMaltcoinABI()
+	require.NoError(t, err, "Error getting Maltcoin ABI")
+
+	// Approve the second account using string arguments
+	method, err := GetMethod(contractABI, "approve")
+	require.NoError(t, err, "Error getting method")
+	args, err := ParseArgs(method.Inputs, []string{addresses[1].Hex(), "1000"}, address.DefaultHRP)
+	require.NoError(t, err, "Error parsing arguments")
+
+	_, err = TransactMethod(auth, client, contractABI, contractAddress, "approve", args)
+	require.NoError(t, err, "Error sending approval")
+	client.Commit()
+
+	// Query the allowance using string arguments
+	method, err = GetMethod(contractABI, "allowance")
+	require.NoError(t, err, "Error getting method")
+	args, err = ParseArgs(method.Inputs, []string{addresses[0].Hex(), addresses[1].Hex()}, address.DefaultHRP)
+	require.NoError(t, err, "Error parsing arguments")
+
+	outputs, err := CallMethod(context.Background(), client, contractABI, contractAddress, addresses[0], "allowance", args, nil)
+	require.NoError(t, err, "Error calling allowance")
+	require.Len(t, outputs, 1, "Wrong number of outputs")
+	require.Equal(t, "1000", FormatValue(outputs[0]), "Wrong allowance")
+
+	// Calling a method with the wrong number of arguments fails
+	_, err = ParseArgs(method.Inputs, []string{addresses[0].Hex()}, address.DefaultHRP)
+	require.Error(t, err, "Wrong number of arguments should raise an error")
+}
+
+// TestFormatValue tests the human-readable representation of decoded
+// output values.
+func TestFormatValue(t *testing.T) {
+	testAddress := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	testcases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"address", testAddress, "0x1234567890123456789012345678901234567890"},
+		{"big integer", big.NewInt(42), "42"},
+		{"bytes", []byte{0xab, 0xcd}, "0xabcd"},
+		{"fixed bytes", [2]byte{0xab, 0xcd}, "0xabcd"},
+		{"string", "MALT", `"MALT"`},
+		{"bool", true, "true"},
+		{"uint8", uint8(18), "18"},
+		{"slice", []*big.Int{big.NewInt(1), big.NewInt(2)}, "[1, 2]"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, FormatValue(tc.value), "Wrong formatted value")
+		})
+	}
+}
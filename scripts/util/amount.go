@@ -0,0 +1,185 @@
+// amount.go contains the token amounts, which are parsed from and formatted
+// as whole tokens using the decimals of the token contract.
+package util
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrInvalidAmount is returned, when a token amount can't be parsed.
+	ErrInvalidAmount = errors.New("invalid token amount")
+
+	// ErrExcessPrecision is returned, when a token amount has more decimal
+	// places than the token supports.
+	ErrExcessPrecision = errors.New("amount has more decimal places than the token")
+)
+
+// TokenUnit describes, in which unit the amounts of a token are given. By
+// default, amounts are whole tokens with the decimals of the contract, e.g.
+// "1.5 MALT". Raw units are the smallest unit of the token, which is used by
+// the contract itself, e.g. "1500000000000000000 aMALT".
+type TokenUnit struct {
+	Symbol   string
+	Decimals uint8
+	Raw      bool
+}
+
+// GetTokenUnit queries the symbol and decimals of the token contract at the
+// given address. The symbol is optional for ERC-20 tokens, so it is left
+// empty, if it can't be queried.
+func GetTokenUnit(opts *bind.CallOpts, caller bind.ContractCaller, contractAddress common.Address) (TokenUnit, error) {
+	contract, err := maltcoin.NewMaltcoinCaller(contractAddress, caller)
+	if err != nil {
+		return TokenUnit{}, err
+	}
+	decimals, err := contract.Decimals(opts)
+	if err != nil {
+		return TokenUnit{}, fmt.Errorf("failed to retrieve token decimals: %w", err)
+	}
+	symbol, _ := contract.Symbol(opts)
+
+	return TokenUnit{Symbol: symbol, Decimals: decimals}, nil
+}
+
+// Name returns the name of the unit, which is the symbol of the token or,
+// for raw units, the symbol with the atto prefix "a".
+func (u TokenUnit) Name() string {
+	if u.Raw && u.Symbol != "" {
+		return "a" + u.Symbol
+	}
+
+	return u.Symbol
+}
+
+// TokenAmount is an amount of tokens in the smallest unit together with the
+// unit, in which it is displayed.
+type TokenAmount struct {
+	Value *big.Int
+	Unit  TokenUnit
+}
+
+// NewTokenAmount returns the amount in the smallest unit of the token with
+// the given display unit.
+func NewTokenAmount(value *big.Int, unit TokenUnit) TokenAmount {
+	return TokenAmount{Value: value, Unit: unit}
+}
+
+// ParseTokenAmount parses a non-negative decimal amount in the given unit,
+// e.g. "1.5", "1,000.25" or "1.5MALT". The name of the unit may follow the
+// number and is matched case-insensitively. Amounts with more decimal places
+// than the unit has are rejected with ErrExcessPrecision.
+func ParseTokenAmount(value string, unit TokenUnit) (TokenAmount, error) {
+	number := strings.TrimSpace(value)
+	if name := unit.Name(); name != "" && len(number) > len(name) && strings.EqualFold(number[len(number)-len(name):], name) {
+		number = strings.TrimSpace(number[:len(number)-len(name)])
+	}
+
+	integer, fraction, hasPoint := strings.Cut(number, ".")
+	integer, ok := ungroup(integer)
+	if !ok || (integer == "" && fraction == "") || (hasPoint && fraction == "") || !isDigits(integer) || !isDigits(fraction) {
+		return TokenAmount{}, fmt.Errorf("%w %q", ErrInvalidAmount, value)
+	}
+
+	decimals := int(unit.Decimals)
+	if unit.Raw {
+		decimals = 0
+	}
+	// Trailing zeros don't add precision
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > decimals {
+		return TokenAmount{}, fmt.Errorf("%w %q: %s has %d decimals", ErrExcessPrecision, value, unit.Name(), decimals)
+	}
+
+	amount, ok := new(big.Int).SetString(integer+fraction+strings.Repeat("0", decimals-len(fraction)), 10)
+	if !ok {
+		return TokenAmount{}, fmt.Errorf("%w %q", ErrInvalidAmount, value)
+	}
+
+	return TokenAmount{Value: amount, Unit: unit}, nil
+}
+
+// String formats the amount in its unit followed by the unit name. Whole
+// tokens are grouped with thousands separators, e.g. "1,234.5 MALT", while
+// raw amounts are plain integers.
+func (a TokenAmount) String() string {
+	return strings.TrimSpace(a.Number() + " " + a.Unit.Name())
+}
+
+// Number formats the amount in its unit without the unit name.
+func (a TokenAmount) Number() string {
+	if a.Unit.Raw {
+		return a.Value.String()
+	}
+
+	formatted := FormatUnits(a.Value, a.Unit.Decimals)
+	sign := ""
+	if strings.HasPrefix(formatted, "-") {
+		sign, formatted = "-", formatted[1:]
+	}
+	integer, fraction, hasPoint := strings.Cut(formatted, ".")
+	if hasPoint {
+		fraction = "." + fraction
+	}
+
+	return sign + group(integer) + fraction
+}
+
+// Plain formats the amount in its unit without thousands separators and
+// unit name, e.g. for CSV files.
+func (a TokenAmount) Plain() string {
+	if a.Unit.Raw {
+		return a.Value.String()
+	}
+
+	return FormatUnits(a.Value, a.Unit.Decimals)
+}
+
+// group inserts thousands separators into the digits of an integer.
+func group(digits string) string {
+	var grouped strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return grouped.String()
+}
+
+// ungroup removes the thousands separators from the integer part of an
+// amount. The second return value is false, if the digits are not grouped
+// by three.
+func ungroup(integer string) (string, bool) {
+	if !strings.Contains(integer, ",") {
+		return integer, true
+	}
+
+	groups := strings.Split(integer, ",")
+	for i, g := range groups {
+		if (i == 0 && (len(g) == 0 || len(g) > 3)) || (i > 0 && len(g) != 3) {
+			return "", false
+		}
+	}
+
+	return strings.Join(groups, ""), true
+}
+
+// isDigits reports, if the string contains only decimal digits.
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,91 @@
+// amount_test.go contains the unit tests for parsing and formatting token
+// amounts.
+package util
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseTokenAmount tests parsing amounts in whole tokens and raw units.
+func TestParseTokenAmount(t *testing.T) {
+	malt := TokenUnit{Symbol: "MALT", Decimals: 18}
+	raw := TokenUnit{Symbol: "MALT", Decimals: 18, Raw: true}
+	oneAndHalf := new(big.Int).Div(new(big.Int).Mul(big.NewInt(3), Ten18), big.NewInt(2))
+
+	testcases := []struct {
+		name     string
+		value    string
+		unit     TokenUnit
+		expected *big.Int
+		expErr   error
+	}{
+		{"integer", "10", malt, new(big.Int).Mul(big.NewInt(10), Ten18), nil},
+		{"decimal", "1.5", malt, oneAndHalf, nil},
+		{"symbol", "1.5MALT", malt, oneAndHalf, nil},
+		{"symbol with space", "1.5 malt", malt, oneAndHalf, nil},
+		{"leading point", ".5", malt, new(big.Int).Div(Ten18, big.NewInt(2)), nil},
+		{"trailing zeros", "1.500000000000000000000", malt, oneAndHalf, nil},
+		{"thousands separators", "1,000,000", malt, new(big.Int).Mul(big.NewInt(1000000), Ten18), nil},
+		{"smallest unit", "0.000000000000000001", malt, big.NewInt(1), nil},
+		{"zero decimals", "42", TokenUnit{Symbol: "T"}, big.NewInt(42), nil},
+		{"raw", "1500", raw, big.NewInt(1500), nil},
+		{"raw with symbol", "1500aMALT", raw, big.NewInt(1500), nil},
+		{"excess precision", "0.0000000000000000001", malt, nil, ErrExcessPrecision},
+		{"raw fraction", "1.5", raw, nil, ErrExcessPrecision},
+		{"wrong symbol", "1.5ETH", malt, nil, ErrInvalidAmount},
+		{"raw symbol for whole tokens", "1.5aMALT", malt, nil, ErrInvalidAmount},
+		{"negative", "-1", malt, nil, ErrInvalidAmount},
+		{"empty", "", malt, nil, ErrInvalidAmount},
+		{"point only", ".", malt, nil, ErrInvalidAmount},
+		{"trailing point", "1.", malt, nil, ErrInvalidAmount},
+		{"wrong grouping", "1,00", malt, nil, ErrInvalidAmount},
+		{"exponent", "1e18", malt, nil, ErrInvalidAmount},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			amount, err := ParseTokenAmount(tc.value, tc.unit)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr, "Expected an error for %q", tc.value)
+				return
+			}
+			require.NoError(t, err, "Error parsing %q", tc.value)
+			require.Equal(t, tc.expected, amount.Value, "Wrong amount")
+		})
+	}
+}
+
+// TestTokenAmountString tests formatting amounts for display.
+func TestTokenAmountString(t *testing.T) {
+	malt := TokenUnit{Symbol: "MALT", Decimals: 18}
+
+	testcases := []struct {
+		value    *big.Int
+		unit     TokenUnit
+		expected string
+		expPlain string
+	}{
+		{new(big.Int).Mul(big.NewInt(1234567), Ten18), malt, "1,234,567 MALT", "1234567"},
+		{big.NewInt(1500), TokenUnit{Symbol: "T", Decimals: 3}, "1.5 T", "1.5"},
+		{big.NewInt(-1234500), TokenUnit{Symbol: "T", Decimals: 3}, "-1,234.5 T", "-1234.5"},
+		{big.NewInt(123), TokenUnit{Decimals: 3}, "0.123", "0.123"},
+		{big.NewInt(1234567), TokenUnit{Symbol: "MALT", Decimals: 18, Raw: true}, "1234567 aMALT", "1234567"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			amount := NewTokenAmount(tc.value, tc.unit)
+			require.Equal(t, tc.expected, amount.String(), "Wrong formatted amount")
+			require.Equal(t, tc.expPlain, amount.Plain(), "Wrong plain amount")
+
+			parsed, err := ParseTokenAmount(amount.String(), tc.unit)
+			if tc.value.Sign() >= 0 {
+				require.NoError(t, err, "Formatted amount should be parsed again")
+				require.Equal(t, tc.value, parsed.Value, "Wrong parsed amount")
+			}
+		})
+	}
+}
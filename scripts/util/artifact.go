@@ -0,0 +1,185 @@
+// artifact.go contains the build artifacts of contracts, i.e. the ABI and
+// the creation bytecode, which are loaded from the Go bindings, from solc
+// output files or by compiling the Solidity source.
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrIncompatibleABI is returned, when an artifact lacks methods or events,
+// which are expected by the Go bindings.
+var ErrIncompatibleABI = errors.New("ABI is incompatible")
+
+// Artifact contains the ABI and the creation bytecode of a contract.
+type Artifact struct {
+	Name string
+	ABI  *abi.ABI
+	Bin  []byte
+}
+
+// MaltcoinArtifact returns the artifact of the Maltcoin contract, which is
+// contained in the Go bindings.
+func MaltcoinArtifact() (*Artifact, error) {
+	maltcoinABI, err := GetMaltcoinABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Artifact{Name: "Maltcoin", ABI: maltcoinABI, Bin: common.FromHex(maltcoin.MaltcoinMetaData.Bin)}, nil
+}
+
+// LoadArtifact reads the artifact of a contract from the ABI and bin files,
+// which are written by solc with --abi and --bin.
+func LoadArtifact(name, abiPath, binPath string) (*Artifact, error) {
+	contractABI, err := LoadABI(abiPath)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := os.ReadFile(binPath)
+	if err != nil {
+		return nil, err
+	}
+	bin, err := decodeBin(string(bz))
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode in %s: %w", binPath, err)
+	}
+
+	return &Artifact{Name: name, ABI: contractABI, Bin: bin}, nil
+}
+
+// CompileArtifact compiles the given Solidity source with the solc binary
+// and returns the artifact of the contract with the given name. Imports are
+// resolved relative to the working directory.
+func CompileArtifact(ctx context.Context, solc, source, name string) (*Artifact, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, solc, "--combined-json", "abi,bin", source)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to compile %s: %w: %s", source, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return parseCombinedJSON(stdout.Bytes(), name)
+}
+
+// parseCombinedJSON returns the artifact of the contract with the given name
+// from the --combined-json output of solc. The contracts are keyed by
+// "<source>:<name>".
+func parseCombinedJSON(bz []byte, name string) (*Artifact, error) {
+	var output struct {
+		Contracts map[string]struct {
+			ABI json.RawMessage `json:"abi"`
+			Bin string          `json:"bin"`
+		} `json:"contracts"`
+	}
+	if err := json.Unmarshal(bz, &output); err != nil {
+		return nil, fmt.Errorf("invalid compiler output: %w", err)
+	}
+
+	for key, contract := range output.Contracts {
+		if key != name && !strings.HasSuffix(key, ":"+name) {
+			continue
+		}
+
+		// Older compilers encode the ABI as JSON string
+		abiJSON := contract.ABI
+		var encoded string
+		if err := json.Unmarshal(abiJSON, &encoded); err == nil {
+			abiJSON = json.RawMessage(encoded)
+		}
+		contractABI, err := abi.JSON(bytes.NewReader(abiJSON))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ABI of %s: %w", key, err)
+		}
+		bin, err := decodeBin(contract.Bin)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bytecode of %s: %w", key, err)
+		}
+
+		return &Artifact{Name: name, ABI: &contractABI, Bin: bin}, nil
+	}
+
+	return nil, fmt.Errorf("contract %s not found in compiler output", name)
+}
+
+// decodeBin decodes the hex encoded bytecode of a contract. Bytecode with
+// unresolved library placeholders is rejected.
+func decodeBin(value string) ([]byte, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "0x")
+	if value == "" {
+		return nil, errors.New("empty bytecode")
+	}
+	if strings.Contains(value, "__") {
+		return nil, errors.New("bytecode contains unlinked libraries")
+	}
+
+	return hex.DecodeString(value)
+}
+
+// Implements checks, that the artifact contains all methods and events of
+// the given ABI with the same signatures, so that the contract can be used
+// with the bindings of that ABI.
+func (a *Artifact) Implements(expected *abi.ABI) error {
+	var missing []string
+	for name, method := range expected.Methods {
+		if actual, ok := a.ABI.Methods[name]; !ok || actual.Sig != method.Sig {
+			missing = append(missing, method.Sig)
+		}
+	}
+	for name, event := range expected.Events {
+		if actual, ok := a.ABI.Events[name]; !ok || actual.Sig != event.Sig {
+			missing = append(missing, "event "+event.Sig)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%w: %s lacks %s", ErrIncompatibleABI, a.Name, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// DeployArtifact fills the transaction signer fields for the deployment of
+// the artifact with the given constructor arguments and deploys it. The
+// transaction is committed, if the given backend is a simulated backend.
+func DeployArtifact(auth *bind.TransactOpts, backend Backend, artifact *Artifact, feeOpts FeeOptions, args ...interface{}) (common.Address, *types.Transaction, error) {
+	constructorArgs, err := artifact.ABI.Pack("", args...)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   nil,
+		Data: append(common.CopyBytes(artifact.Bin), constructorArgs...),
+	}
+	auth, err = FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	contractAddress, tx, _, err := bind.DeployContract(auth, *artifact.ABI, artifact.Bin, backend, args...)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	// Commit transaction on simulated backend
+	Commit(backend)
+
+	return contractAddress, tx, nil
+}
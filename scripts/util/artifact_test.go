@@ -0,0 +1,144 @@
+// artifact_test.go contains the unit tests for loading and deploying the
+// build artifacts of contracts.
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoadArtifact tests loading artifacts from the solc output files.
+func TestLoadArtifact(t *testing.T) {
+	dir := t.TempDir()
+	abiPath := filepath.Join(dir, "Maltcoin.abi")
+	binPath := filepath.Join(dir, "Maltcoin.bin")
+	invalidBinPath := filepath.Join(dir, "invalid.bin")
+	linkBinPath := filepath.Join(dir, "link.bin")
+
+	require.NoError(t, os.WriteFile(abiPath, []byte(maltcoin.MaltcoinMetaData.ABI), 0o600))
+	require.NoError(t, os.WriteFile(binPath, []byte(strings.TrimPrefix(maltcoin.MaltcoinMetaData.Bin, "0x")+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(invalidBinPath, []byte("60806x"), 0o600))
+	require.NoError(t, os.WriteFile(linkBinPath, []byte("6080__$1234$__6040"), 0o600))
+
+	testcases := []struct {
+		name    string
+		expErr  string
+		abiPath string
+		binPath string
+	}{
+		{"passes - solc output", "", abiPath, binPath},
+		{"fails - missing ABI", "no such file", filepath.Join(dir, "missing.abi"), binPath},
+		{"fails - missing bin", "no such file", abiPath, filepath.Join(dir, "missing.bin")},
+		{"fails - invalid hex", "invalid bytecode", abiPath, invalidBinPath},
+		{"fails - unlinked library", "unlinked libraries", abiPath, linkBinPath},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			artifact, err := LoadArtifact("Maltcoin", tc.abiPath, tc.binPath)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err, "Error loading artifact")
+
+			expected, err := MaltcoinArtifact()
+			require.NoError(t, err, "Error getting artifact of the bindings")
+			require.Equal(t, expected.Bin, artifact.Bin, "Bytecode should match the bindings")
+			require.NoError(t, artifact.Implements(expected.ABI), "ABI should match the bindings")
+		})
+	}
+}
+
+// TestParseCombinedJSON tests reading an artifact from the combined JSON
+// output of solc, which contains the ABI as array or, for older compilers,
+// as string.
+func TestParseCombinedJSON(t *testing.T) {
+	bin := strings.TrimPrefix(maltcoin.MaltcoinMetaData.Bin, "0x")
+	combined := func(key string, abiValue interface{}) []byte {
+		bz, err := json.Marshal(map[string]interface{}{
+			"contracts": map[string]interface{}{
+				key: map[string]interface{}{"abi": abiValue, "bin": bin},
+			},
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	testcases := []struct {
+		name   string
+		expErr string
+		output []byte
+	}{
+		{"passes - ABI as array", "", combined("contracts/Maltcoin.sol:Maltcoin", json.RawMessage(maltcoin.MaltcoinMetaData.ABI))},
+		{"passes - ABI as string", "", combined("contracts/Maltcoin.sol:Maltcoin", maltcoin.MaltcoinMetaData.ABI)},
+		{"fails - other contract", "not found", combined("contracts/Maltcoin.sol:OtherMaltcoin", json.RawMessage(maltcoin.MaltcoinMetaData.ABI))},
+		{"fails - invalid output", "invalid compiler output", []byte("Error: not found")},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			artifact, err := parseCombinedJSON(tc.output, "Maltcoin")
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err, "Error parsing compiler output")
+			require.Equal(t, "Maltcoin", artifact.Name)
+			require.Contains(t, artifact.ABI.Methods, "transfer", "ABI should contain transfer")
+		})
+	}
+}
+
+// TestArtifactImplements tests the check, that an artifact can be used with
+// the Maltcoin bindings.
+func TestArtifactImplements(t *testing.T) {
+	expected, err := GetMaltcoinABI()
+	require.NoError(t, err)
+
+	incompatible, err := abi.JSON(strings.NewReader(`[
+		{"type": "function", "name": "transfer", "inputs": [{"name": "to", "type": "address"}], "outputs": []}
+	]`))
+	require.NoError(t, err)
+
+	err = (&Artifact{Name: "Other", ABI: &incompatible}).Implements(expected)
+	require.ErrorIs(t, err, ErrIncompatibleABI)
+	require.ErrorContains(t, err, "transfer(address,uint256)")
+	require.ErrorContains(t, err, "event Transfer(address,address,uint256)")
+
+	require.NoError(t, (&Artifact{Name: "Maltcoin", ABI: expected}).Implements(expected))
+}
+
+// TestDeployArtifact tests deploying an artifact on a simulated backend.
+func TestDeployArtifact(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private key")
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting client and transaction signer")
+
+	artifact, err := MaltcoinArtifact()
+	require.NoError(t, err)
+	contractAddress, tx, err := DeployArtifact(auth, client, artifact, DefaultFeeOptions(), DefaultTokenSettings().Args()...)
+	require.NoError(t, err, "Error deploying artifact")
+
+	receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(t, err, "Error getting receipt")
+	require.Equal(t, contractAddress, receipt.ContractAddress)
+
+	contract, err := GetContract(client, contractAddress)
+	require.NoError(t, err, "Error loading deployed contract")
+	balance, err := contract.BalanceOf(nil, addresses[0])
+	require.NoError(t, err)
+	require.Positive(t, balance.Sign(), "Deployer should hold the initial supply")
+
+	_, _, err = DeployArtifact(auth, client, artifact, DefaultFeeOptions(), "unexpected")
+	require.Error(t, err, "Constructor arguments should be checked")
+}
@@ -0,0 +1,66 @@
+// backend.go contains the backend abstraction, which is used by the utility
+// functions. Both an ethclient connected to a running node and a simulated
+// backend implement it, so that the same code paths can be exercised in
+// the tests and against a live network.
+package util
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Backend defines an interface, which contains all methods the utility
+// functions need from a given ethclient or simulated backend: querying the
+// chain ID, suggesting gas prices, getting nonces, estimating gas, sending
+// transactions and getting them and their receipts.
+type Backend interface {
+	bind.ContractBackend
+	ReceiptBackend
+
+	ChainID(ctx context.Context) (*big.Int, error)
+	TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error)
+}
+
+// Committer defines an interface for backends, which do not produce blocks
+// on their own, like the simulated backend. Pending transactions are only
+// included in a block, when Commit is called.
+type Committer interface {
+	Commit()
+}
+
+// Commit includes the pending transactions in a new block, if the given
+// backend needs to be committed explicitly. For all other backends, e.g.
+// a connection to a running node, the function does nothing.
+func Commit(backend interface{}) {
+	if committer, ok := backend.(Committer); ok {
+		committer.Commit()
+	}
+}
+
+// SimulatedClient wraps the go-ethereum simulated backend, so that it
+// implements the Backend interface.
+type SimulatedClient struct {
+	*backends.SimulatedBackend
+}
+
+// ChainID returns the chain ID of the simulated chain.
+func (c *SimulatedClient) ChainID(_ context.Context) (*big.Int, error) {
+	return c.Blockchain().Config().ChainID, nil
+}
+
+// NewTransactionSigner queries the chain ID from the given backend and
+// uses it together with the private key to create a transaction signer.
+func NewTransactionSigner(backend Backend, privKey *ecdsa.PrivateKey) (*bind.TransactOpts, error) {
+	chainID, err := backend.ChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return bind.NewKeyedTransactorWithChainID(privKey, chainID)
+}
@@ -0,0 +1,107 @@
+// backend_test.go contains the unit tests for the backend abstraction,
+// which is shared by the simulated backend and a connection to a node.
+package util
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Compile-time check, that the simulated client implements the Backend
+// interface.
+var _ Backend = &SimulatedClient{}
+
+// TestNewTransactionSigner tests, that the transaction signer uses the
+// chain ID reported by the backend.
+func TestNewTransactionSigner(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private key")
+
+	client, _, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client and transaction signer")
+
+	chainID, err := client.ChainID(context.Background())
+	require.NoError(t, err, "Error getting chain ID")
+	require.Equal(t, TestChainID, chainID, "Wrong chain ID")
+
+	auth, err := NewTransactionSigner(client, privKeys[0])
+	require.NoError(t, err, "Error creating transaction signer")
+	require.Equal(t, addresses[0], auth.From, "Wrong signer address")
+
+	// The signer is usable on the backend
+	_, _, _, err = DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+}
+
+// TestDeployContractAndTransferTokens tests the deploy and transfer
+// helpers, which fill the transaction signer fields the same way for
+// a simulated backend and a running node.
+func TestDeployContractAndTransferTokens(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+
+	testcases := []struct {
+		name      string
+		expErr    bool
+		feeOpts   FeeOptions
+		amount    *big.Int
+		expTxType uint8
+	}{
+		{
+			"passes - dynamic fees",
+			false,
+			DefaultFeeOptions(),
+			big.NewInt(1000),
+			types.DynamicFeeTxType,
+		},
+		{
+			"passes - legacy fees",
+			false,
+			FeeOptions{Strategy: GasStrategyLegacy},
+			big.NewInt(1000),
+			types.LegacyTxType,
+		},
+		{
+			"fails - amount exceeds balance",
+			true,
+			DefaultFeeOptions(),
+			new(big.Int).Mul(big.NewInt(20000), Ten18),
+			0,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+			require.NoError(t, err, "Error getting simulated client and transaction signer")
+
+			contractAddress, tx, contract, err := DeployContract(auth, client, DefaultTokenSettings(), tc.feeOpts)
+			require.NoError(t, err, "Error deploying contract")
+
+			// The deployment was committed, so it can be waited for
+			opts := WaitOptions{Timeout: time.Second, PollInterval: 10 * time.Millisecond, Confirmations: 1}
+			_, err = WaitForTransaction(context.Background(), client, tx.Hash(), opts)
+			require.NoError(t, err, "Error waiting for deployment")
+
+			tx, err = TransferTokens(auth, client, contractAddress, addresses[1], tc.amount, tc.feeOpts)
+			if tc.expErr {
+				require.Error(t, err, "Transfer should fail")
+				return
+			}
+			require.NoError(t, err, "Error transferring tokens")
+			require.Equal(t, tc.expTxType, tx.Type(), "Wrong transaction type")
+
+			_, err = WaitForTransaction(context.Background(), client, tx.Hash(), opts)
+			require.NoError(t, err, "Error waiting for transfer")
+
+			balance, err := contract.BalanceOf(nil, addresses[1])
+			require.NoError(t, err, "Error getting balance")
+			require.Equal(t, tc.amount, balance, "Wrong balance of recipient")
+		})
+	}
+}
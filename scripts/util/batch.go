@@ -0,0 +1,655 @@
+// batch.go contains the functions to distribute tokens to many recipients,
+// which are read from a CSV file. Every transfer is recorded in a journal,
+// so that an interrupted batch can be resumed without paying any recipient
+// twice.
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/address"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Status values of the transfers in a batch journal
+const (
+	// TransferPending marks a transfer, which was signed and possibly
+	// broadcast, but not yet confirmed.
+	TransferPending = "pending"
+	// TransferConfirmed marks a transfer, which was executed successfully.
+	TransferConfirmed = "confirmed"
+	// TransferFailed marks a transfer, which was not executed. It is
+	// retried, when the batch is resumed.
+	TransferFailed = "failed"
+)
+
+var (
+	// ErrInsufficientBalance is returned, when the token balance of the
+	// sender does not cover the total amount of a batch.
+	ErrInsufficientBalance = errors.New("insufficient token balance")
+
+	// ErrJournalMismatch is returned, when the journal contains a different
+	// transfer for a row than the batch file or belongs to another contract
+	// or sender.
+	ErrJournalMismatch = errors.New("journal does not match batch")
+)
+
+// BatchTransfer defines a single transfer of a batch.
+type BatchTransfer struct {
+	// Row is the line number of the transfer in the batch file, which
+	// identifies the transfer in the journal.
+	Row       int
+	Recipient common.Address
+	Amount    *big.Int
+}
+
+// ReadBatchTransfers reads the transfers from CSV data with address,amount
+// rows. The addresses are given as hex or as bech32 strings with the given
+// human-readable part and the amounts in the given unit of the token. An
+// optional header row and lines starting with # are skipped. All rows are
+// validated and the errors of all invalid rows are returned together.
+func ReadBatchTransfers(r io.Reader, hrp string, unit TokenUnit) ([]BatchTransfer, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var (
+		transfers []BatchTransfer
+		invalid   []string
+	)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) && parseErr.Err == csv.ErrFieldCount {
+				invalid = append(invalid, fmt.Sprintf("line %d: expected address,amount", parseErr.StartLine))
+				continue
+			}
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		addressStr, amountStr := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if len(transfers) == 0 && len(invalid) == 0 && strings.EqualFold(addressStr, "address") {
+			continue
+		}
+
+		recipient, err := address.Parse(addressStr, hrp)
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		amount, err := ParseTokenAmount(amountStr, unit)
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		if amount.Value.Sign() <= 0 {
+			invalid = append(invalid, fmt.Sprintf("line %d: invalid amount %q, must be positive", line, amountStr))
+			continue
+		}
+
+		transfers = append(transfers, BatchTransfer{
+			Row:       line,
+			Recipient: recipient,
+			Amount:    amount.Value,
+		})
+	}
+
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid batch rows:\n  %s", strings.Join(invalid, "\n  "))
+	}
+	if len(transfers) == 0 {
+		return nil, errors.New("batch contains no transfers")
+	}
+
+	return transfers, nil
+}
+
+// JournalEntry records the state of a transfer of a batch. The signed
+// transaction is stored, so that it can be broadcast again, if the batch is
+// interrupted before the transaction reached the node.
+type JournalEntry struct {
+	Row       int            `json:"row"`
+	Recipient common.Address `json:"recipient"`
+	Amount    *big.Int       `json:"amount"`
+	Status    string         `json:"status"`
+	TxHash    *common.Hash   `json:"tx_hash,omitempty"`
+	RawTx     hexutil.Bytes  `json:"raw_tx,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// JournalHeader is the first line of a journal and identifies the batch,
+// to which the journal belongs, by the token contract and the sender.
+type JournalHeader struct {
+	Contract common.Address `json:"contract"`
+	Sender   common.Address `json:"sender"`
+}
+
+// Journal is an append-only file, which contains the journal header and one
+// JSON encoded journal entry per line. The latest entry of a row defines the
+// state of its transfer. It is safe for concurrent use.
+type Journal struct {
+	mu      sync.Mutex
+	file    *os.File
+	header  JournalHeader
+	entries map[int]JournalEntry
+}
+
+// OpenJournal opens the journal of the transfers of the sender on the given
+// contract at the given path and reads the existing entries. The file is
+// created with a header, if it does not exist. A journal can only be used
+// for a single contract and sender, so that no transfer is skipped as
+// confirmed, which was made on another contract or by another sender. A
+// truncated last line, which was left by a crash during writing, is removed.
+func OpenJournal(path string, contractAddress, sender common.Address) (*Journal, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	journal, err := readJournal(file, path, JournalHeader{Contract: contractAddress, Sender: sender})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return journal, nil
+}
+
+// readJournal reads the header and the entries of the journal file. The
+// header is written, if the file contains no complete header yet.
+func readJournal(file *os.File, path string, header JournalHeader) (*Journal, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every line is terminated by a newline, so a last line without
+	// newline was not completely written.
+	var (
+		entries   = make(map[int]JournalEntry)
+		hasHeader = false
+		offset    = 0
+	)
+	for line := 1; offset < len(data); line++ {
+		end := bytes.IndexByte(data[offset:], '\n')
+		if end < 0 {
+			if err := file.Truncate(int64(offset)); err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		if line == 1 {
+			var stored JournalHeader
+			if err := json.Unmarshal(data[offset:offset+end], &stored); err != nil || stored.Contract == (common.Address{}) {
+				return nil, fmt.Errorf("invalid journal header in %s", path)
+			}
+			if stored != header {
+				return nil, fmt.Errorf("%w: %s belongs to the transfers of %s on contract %s", ErrJournalMismatch, path, stored.Sender, stored.Contract)
+			}
+			hasHeader = true
+			offset += end + 1
+			continue
+		}
+
+		var entry JournalEntry
+		if err := json.Unmarshal(data[offset:offset+end], &entry); err != nil {
+			return nil, fmt.Errorf("invalid journal entry in %s, line %d: %w", path, line, err)
+		}
+		entries[entry.Row] = entry
+		offset += end + 1
+	}
+
+	journal := &Journal{file: file, header: header, entries: entries}
+	if !hasHeader {
+		if err := journal.writeLine(header); err != nil {
+			return nil, err
+		}
+	}
+
+	return journal, nil
+}
+
+// Header returns the header of the journal.
+func (j *Journal) Header() JournalHeader {
+	return j.header
+}
+
+// Entry returns the latest journal entry of the given row.
+func (j *Journal) Entry(row int) (JournalEntry, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entry, ok := j.entries[row]
+	return entry, ok
+}
+
+// Record appends the given entry to the journal and syncs it to disk,
+// before the function returns.
+func (j *Journal) Record(entry JournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.writeLine(entry); err != nil {
+		return err
+	}
+	j.entries[entry.Row] = entry
+
+	return nil
+}
+
+// writeLine appends the JSON encoding of the value as a line to the journal
+// and syncs it to disk.
+func (j *Journal) writeLine(v interface{}) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := j.file.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+
+	return j.file.Sync()
+}
+
+// Close closes the journal file.
+func (j *Journal) Close() error {
+	return j.file.Close()
+}
+
+// BatchOptions define how the transfers of a batch are sent.
+type BatchOptions struct {
+	// Concurrency is the maximum number of transfers, which are waiting
+	// for confirmation at the same time.
+	Concurrency int
+	Fees        FeeOptions
+	Wait        WaitOptions
+	// OnUpdate is called, whenever a journal entry was recorded.
+	OnUpdate func(JournalEntry)
+}
+
+// BatchSummary contains the results of a batch.
+type BatchSummary struct {
+	// Total is the amount of tokens, which was still to be transferred
+	// at the start of the batch.
+	Total     *big.Int
+	Confirmed int
+	Failed    int
+	// Skipped is the number of transfers, which were already confirmed
+	// in a previous run.
+	Skipped int
+}
+
+// ValidateBatch checks the transfers against the journal and returns the
+// total amount of all transfers, which are not yet confirmed. An error is
+// returned, if the journal does not match the contract, the sender or the
+// transfers or the token balance of the sender does not cover the amount of
+// the transfers, which were never signed. Pending transfers are left out of
+// the balance check, because they might have been executed already, which
+// reduced the balance. SendBatch checks the balance again, once they are
+// reconciled.
+func ValidateBatch(backend bind.ContractCaller, contractAddress, from common.Address, transfers []BatchTransfer, journal *Journal) (*big.Int, error) {
+	if header := journal.Header(); header.Contract != contractAddress || header.Sender != from {
+		return nil, fmt.Errorf("%w: journal belongs to the transfers of %s on contract %s", ErrJournalMismatch, header.Sender, header.Contract)
+	}
+
+	total, unsigned := new(big.Int), new(big.Int)
+	for _, transfer := range transfers {
+		if entry, ok := journal.Entry(transfer.Row); ok {
+			if entry.Recipient != transfer.Recipient || entry.Amount.Cmp(transfer.Amount) != 0 {
+				return nil, fmt.Errorf("%w: row %d is recorded as %v to %s", ErrJournalMismatch, transfer.Row, entry.Amount, entry.Recipient)
+			}
+			switch entry.Status {
+			case TransferConfirmed:
+				continue
+			case TransferPending:
+				total.Add(total, transfer.Amount)
+				continue
+			}
+		}
+		total.Add(total, transfer.Amount)
+		unsigned.Add(unsigned, transfer.Amount)
+	}
+
+	if err := checkBalance(backend, contractAddress, from, unsigned); err != nil {
+		return nil, err
+	}
+
+	return total, nil
+}
+
+// checkBalance returns ErrInsufficientBalance, if the token balance of the
+// sender is less than the given amount.
+func checkBalance(backend bind.ContractCaller, contractAddress, from common.Address, amount *big.Int) error {
+	caller, err := maltcoin.NewMaltcoinCaller(contractAddress, backend)
+	if err != nil {
+		return err
+	}
+	balance, err := caller.BalanceOf(nil, from)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(amount) < 0 {
+		return fmt.Errorf("%w: balance %v of %s is less than the total %v", ErrInsufficientBalance, balance, from, amount)
+	}
+
+	return nil
+}
+
+// SendBatch transfers the tokens of all transfers, which are not yet
+// confirmed in the journal. Transfers, which are pending from a previous
+// run, are reconciled with the node first: their signed transactions are
+// broadcast again, so that no recipient is paid twice. Afterwards, the token
+// balance of the sender has to cover the remaining transfers. The
+// transactions are signed and broadcast in order, while up to
+// opts.Concurrency transfers are awaiting their confirmation at the same
+// time.
+func SendBatch(ctx context.Context, backend Backend, auth *bind.TransactOpts, contractAddress common.Address, transfers []BatchTransfer, journal *Journal, opts BatchOptions) (*BatchSummary, error) {
+	total, err := ValidateBatch(backend, contractAddress, auth.From, transfers, journal)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+
+	contract, err := GetContract(backend, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := SuggestFees(ctx, backend, opts.Fees)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &batch{
+		backend:  backend,
+		journal:  journal,
+		opts:     opts,
+		summary:  &BatchSummary{Total: total},
+		nonces:   NewNonceManager(backend),
+		contract: contract,
+		address:  contractAddress,
+	}
+
+	// Reconcile transfers, which were pending when the last run stopped
+	var open []BatchTransfer
+	remaining := new(big.Int)
+	for _, transfer := range transfers {
+		entry, ok := journal.Entry(transfer.Row)
+		switch {
+		case ok && entry.Status == TransferConfirmed:
+			b.summary.Skipped++
+			continue
+		case ok && entry.Status == TransferPending:
+			status, err := b.reconcile(ctx, entry)
+			if err != nil {
+				return nil, err
+			}
+			if status == TransferConfirmed {
+				b.summary.Confirmed++
+				continue
+			}
+		}
+		open = append(open, transfer)
+		remaining.Add(remaining, transfer.Amount)
+	}
+	if err := checkBalance(backend, contractAddress, auth.From, remaining); err != nil {
+		return b.summary, err
+	}
+
+	var (
+		wg    sync.WaitGroup
+		slots = make(chan struct{}, opts.Concurrency)
+	)
+	for _, transfer := range open {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return b.summary, ctx.Err()
+		}
+
+		entry, err := b.send(ctx, auth, fees, transfer)
+		if err != nil {
+			<-slots
+			wg.Wait()
+			return b.summary, err
+		}
+		if entry.Status != TransferPending {
+			<-slots
+			continue
+		}
+
+		wg.Add(1)
+		go func(entry JournalEntry) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			b.wait(ctx, entry)
+		}(entry)
+	}
+	wg.Wait()
+
+	return b.summary, b.err
+}
+
+// batch contains the state of a running batch.
+type batch struct {
+	backend  Backend
+	journal  *Journal
+	opts     BatchOptions
+	nonces   *NonceManager
+	contract *maltcoin.Maltcoin
+	address  common.Address
+
+	mu      sync.Mutex
+	summary *BatchSummary
+	err     error
+}
+
+// record writes the entry to the journal and updates the summary.
+func (b *batch) record(entry JournalEntry) error {
+	if err := b.journal.Record(entry); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	switch entry.Status {
+	case TransferConfirmed:
+		b.summary.Confirmed++
+	case TransferFailed:
+		b.summary.Failed++
+	}
+	b.mu.Unlock()
+
+	if b.opts.OnUpdate != nil {
+		b.opts.OnUpdate(entry)
+	}
+
+	return nil
+}
+
+// send signs the transfer, records it as pending and broadcasts it. If the
+// transfer cannot be signed, e.g. because the gas estimation fails, it is
+// recorded as failed. Errors of the journal and of broadcasting are returned,
+// because the transaction might have reached the node and the transfer
+// has to stay pending to be reconciled.
+func (b *batch) send(ctx context.Context, auth *bind.TransactOpts, fees *Fees, transfer BatchTransfer) (JournalEntry, error) {
+	entry := JournalEntry{
+		Row:       transfer.Row,
+		Recipient: transfer.Recipient,
+		Amount:    transfer.Amount,
+	}
+
+	var fatalErr error
+	_, err := b.nonces.Send(ctx, auth, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		fees.apply(opts, &ethereum.CallMsg{})
+		opts.Context = ctx
+
+		// Sign the transaction without sending it, so that it can be
+		// recorded in the journal before it is broadcast.
+		opts.NoSend = true
+		tx, err := b.contract.Transfer(opts, transfer.Recipient, transfer.Amount)
+		if err != nil {
+			return nil, b.diagnoseTransfer(ctx, opts.From, transfer, err)
+		}
+		raw, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		txHash := tx.Hash()
+		entry.Status, entry.TxHash, entry.RawTx, entry.Error = TransferPending, &txHash, raw, ""
+		if err := b.record(entry); err != nil {
+			fatalErr = err
+			return nil, err
+		}
+
+		// Transactions rejected due to their nonce were never executed,
+		// so they are retried with a new nonce. Transactions, which the
+		// node already knows, were broadcast before and keep the recorded
+		// hash, so that they are not paid twice.
+		if err := b.backend.SendTransaction(ctx, tx); err != nil && !IsAlreadyKnown(err) {
+			if !IsNonceError(err) {
+				fatalErr = fmt.Errorf("failed to broadcast transfer of row %d: %w", transfer.Row, err)
+			}
+			return nil, err
+		}
+		Commit(b.backend)
+
+		return tx, nil
+	})
+	if fatalErr != nil {
+		return entry, fatalErr
+	}
+	if err != nil {
+		entry.Status, entry.Error = TransferFailed, err.Error()
+		return entry, b.record(entry)
+	}
+
+	return entry, nil
+}
+
+// wait waits for the confirmation of a pending transfer and records its
+// final status.
+func (b *batch) wait(ctx context.Context, entry JournalEntry) {
+	_, err := WaitForTransaction(ctx, b.backend, *entry.TxHash, b.opts.Wait)
+	switch {
+	case err == nil:
+		entry.Status, entry.Error = TransferConfirmed, ""
+	case errors.Is(err, ErrTransactionFailed):
+		entry.Status, entry.Error = TransferFailed, b.diagnoseTransaction(ctx, *entry.TxHash, err).Error()
+	default:
+		// The transaction may still be executed, so the transfer is left
+		// pending and reconciled in the next run.
+		b.mu.Lock()
+		if b.err == nil {
+			b.err = fmt.Errorf("transfer in row %d: %w", entry.Row, err)
+		}
+		b.mu.Unlock()
+		return
+	}
+
+	if err := b.record(entry); err != nil {
+		b.mu.Lock()
+		if b.err == nil {
+			b.err = err
+		}
+		b.mu.Unlock()
+	}
+}
+
+// diagnoseTransfer decodes the revert, which made the gas estimation of
+// the transfer fail.
+func (b *batch) diagnoseTransfer(ctx context.Context, from common.Address, transfer BatchTransfer, err error) error {
+	callData, packErr := GetCallData("transfer", transfer.Recipient, transfer.Amount)
+	if packErr != nil {
+		return err
+	}
+	msg := ethereum.CallMsg{From: from, To: &b.address, Data: callData}
+
+	return DiagnoseCall(ctx, b.backend, msg, nil, err, maltcoinABIs()...)
+}
+
+// diagnoseTransaction determines the reason of a failed transfer. The given
+// error is returned, if the diagnosis fails.
+func (b *batch) diagnoseTransaction(ctx context.Context, txHash common.Hash, err error) error {
+	if diagnosed := DiagnoseTransaction(ctx, b.backend, txHash, maltcoinABIs()...); diagnosed != nil && errors.Is(diagnosed, ErrTransactionFailed) {
+		return diagnosed
+	}
+
+	return err
+}
+
+// reconcile determines the final status of a transfer, which was pending
+// when the previous run stopped. The signed transaction is broadcast again,
+// which is safe, because it can only be executed once. If the node rejects
+// it due to its nonce without a receipt being available, the nonce was used
+// by another transaction and the transfer was never executed.
+func (b *batch) reconcile(ctx context.Context, entry JournalEntry) (string, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(entry.RawTx); err != nil {
+		return "", fmt.Errorf("invalid transaction of row %d in journal: %w", entry.Row, err)
+	}
+
+	dropped := false
+	if _, err := b.backend.TransactionReceipt(ctx, tx.Hash()); errors.Is(err, ethereum.NotFound) {
+		err := b.backend.SendTransaction(ctx, tx)
+		switch {
+		case err == nil, IsAlreadyKnown(err):
+			Commit(b.backend)
+		case IsNonceError(err):
+			// The receipt is checked again, because the transaction may
+			// have been executed in the meantime.
+			_, err := b.backend.TransactionReceipt(ctx, tx.Hash())
+			dropped = errors.Is(err, ethereum.NotFound)
+		default:
+			return "", fmt.Errorf("failed to broadcast pending transfer of row %d: %w", entry.Row, err)
+		}
+	}
+
+	if dropped {
+		entry.Status, entry.Error = TransferFailed, "transaction was dropped and its nonce was used by another transaction"
+	} else {
+		_, err := WaitForTransaction(ctx, b.backend, tx.Hash(), b.opts.Wait)
+		switch {
+		case err == nil:
+			entry.Status, entry.Error = TransferConfirmed, ""
+		case errors.Is(err, ErrTransactionFailed):
+			entry.Status, entry.Error = TransferFailed, b.diagnoseTransaction(ctx, tx.Hash(), err).Error()
+		default:
+			return "", fmt.Errorf("pending transfer of row %d: %w", entry.Row, err)
+		}
+	}
+
+	// Failed transfers are counted, when they are sent again
+	if err := b.journal.Record(entry); err != nil {
+		return "", err
+	}
+	if b.opts.OnUpdate != nil {
+		b.opts.OnUpdate(entry)
+	}
+
+	return entry.Status, nil
+}
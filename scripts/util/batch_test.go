@@ -0,0 +1,412 @@
+// batch_test.go contains the unit tests for the batch distribution of
+// tokens and its journal.
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/address"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testBatchOptions are used to send batches on the simulated backend.
+var testBatchOptions = BatchOptions{
+	Concurrency: 2,
+	Fees:        DefaultFeeOptions(),
+	Wait:        WaitOptions{Timeout: time.Second, PollInterval: 10 * time.Millisecond, Confirmations: 1},
+}
+
+// TestReadBatchTransfers tests reading and validating the rows of
+// a batch file.
+func TestReadBatchTransfers(t *testing.T) {
+	hexAddress := "0x1234567890123456789012345678901234567890"
+	bech32Address := "evmos1zg69v7yszg69v7yszg69v7yszg69v7ysh6sy6u"
+	maltUnit := TokenUnit{Symbol: "MALT", Decimals: 18}
+	rawUnit := TokenUnit{Symbol: "MALT", Decimals: 18, Raw: true}
+
+	testcases := []struct {
+		name    string
+		expErr  string
+		csv     string
+		unit    TokenUnit
+		expRows []int
+	}{
+		{
+			"passes - with header and comments",
+			"",
+			"address,amount\n# first payout\n" + hexAddress + ",100\n" + bech32Address + ", 200\n",
+			rawUnit,
+			[]int{3, 4},
+		},
+		{
+			"passes - without header",
+			"",
+			hexAddress + ",100\n",
+			rawUnit,
+			[]int{1},
+		},
+		{
+			"passes - whole tokens",
+			"",
+			hexAddress + ",1.5\n" + bech32Address + ",\"1,000 MALT\"\n",
+			maltUnit,
+			[]int{1, 2},
+		},
+		{
+			"fails - all invalid rows are reported",
+			"line 3: invalid token amount",
+			"0x1234,100\n" + hexAddress + ",100\n" + hexAddress + ",-5\n",
+			rawUnit,
+			nil,
+		},
+		{
+			"fails - zero amount",
+			"line 1: invalid amount \"0\", must be positive",
+			hexAddress + ",0\n",
+			rawUnit,
+			nil,
+		},
+		{
+			"fails - excess precision",
+			"line 1: amount has more decimal places than the token",
+			hexAddress + ",1.5\n",
+			rawUnit,
+			nil,
+		},
+		{
+			"fails - wrong number of fields",
+			"line 1: expected address,amount",
+			hexAddress + ",100,extra\n",
+			rawUnit,
+			nil,
+		},
+		{
+			"fails - no transfers",
+			"no transfers",
+			"address,amount\n",
+			rawUnit,
+			nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			transfers, err := ReadBatchTransfers(strings.NewReader(tc.csv), address.DefaultHRP, tc.unit)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr, "Invalid batch should raise an error")
+				return
+			}
+
+			require.NoError(t, err, "Error reading batch")
+			require.Len(t, transfers, len(tc.expRows), "Wrong number of transfers")
+			for i, transfer := range transfers {
+				require.Equal(t, tc.expRows[i], transfer.Row, "Wrong row")
+				require.Equal(t, common.HexToAddress(hexAddress), transfer.Recipient, "Wrong recipient")
+			}
+		})
+	}
+}
+
+// TestOpenJournal tests reading existing journals, which may have been
+// interrupted while writing or belong to another batch.
+func TestOpenJournal(t *testing.T) {
+	contractAddress := common.HexToAddress("0x1")
+	sender := common.HexToAddress("0x2")
+	header := `{"contract":"0x0000000000000000000000000000000000000001","sender":"0x0000000000000000000000000000000000000002"}` + "\n"
+	otherSender := `{"contract":"0x0000000000000000000000000000000000000001","sender":"0x0000000000000000000000000000000000000003"}` + "\n"
+	entry := `{"row":1,"recipient":"0x1234567890123456789012345678901234567890","amount":100,"status":"confirmed"}`
+
+	testcases := []struct {
+		name       string
+		expErr     string
+		content    string
+		expEntries int
+		expContent string
+	}{
+		{"passes - new journal", "", "", 0, header},
+		{"passes - complete entries", "", header + entry + "\n", 1, header + entry + "\n"},
+		{"passes - truncated last line is removed", "", header + entry + "\n" + entry[:20], 1, header + entry + "\n"},
+		{"passes - truncated header is written again", "", header[:20], 0, header},
+		{"fails - corrupt entry", "invalid journal entry", header + entry[:20] + "\n" + entry + "\n", 0, ""},
+		{"fails - missing header", "invalid journal header", entry + "\n", 0, ""},
+		{"fails - other sender", ErrJournalMismatch.Error(), otherSender + entry + "\n", 0, ""},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "batch.journal")
+			if tc.content != "" {
+				require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+			}
+
+			journal, err := OpenJournal(path, contractAddress, sender)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr, "Invalid journal should raise an error")
+				return
+			}
+			require.NoError(t, err, "Error opening journal")
+			defer journal.Close()
+
+			require.Len(t, journal.entries, tc.expEntries, "Wrong number of entries")
+			bz, err := os.ReadFile(path)
+			require.NoError(t, err, "Error reading journal")
+			require.Equal(t, tc.expContent, string(bz), "Wrong journal content")
+		})
+	}
+}
+
+// setupBatch deploys the token contract on a simulated backend and returns
+// the transfers to the given number of recipients.
+func setupBatch(t *testing.T, n int) (*SimulatedClient, *bind.TransactOpts, common.Address, *maltcoin.Maltcoin, []BatchTransfer) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(uint64(n + 1))
+	require.NoError(t, err, "Error generating private keys")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client and transaction signer")
+
+	contractAddress, _, contract, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	var csv strings.Builder
+	for i, recipient := range addresses[1:] {
+		fmt.Fprintf(&csv, "%s,%d\n", recipient.Hex(), 100*(i+1))
+	}
+	transfers, err := ReadBatchTransfers(strings.NewReader(csv.String()), address.DefaultHRP, TokenUnit{Raw: true})
+	require.NoError(t, err, "Error reading batch")
+
+	return client, auth, contractAddress, contract, transfers
+}
+
+// requireBalances checks, that every recipient was paid exactly once.
+func requireBalances(t *testing.T, contract *maltcoin.Maltcoin, transfers []BatchTransfer) {
+	for _, transfer := range transfers {
+		balance, err := contract.BalanceOf(nil, transfer.Recipient)
+		require.NoError(t, err, "Error getting balance")
+		require.Equal(t, transfer.Amount, balance, "Wrong balance of recipient in row %d", transfer.Row)
+	}
+}
+
+// TestSendBatch tests sending a batch and running it again with the same
+// journal, which must not pay anyone twice.
+func TestSendBatch(t *testing.T) {
+	client, auth, contractAddress, contract, transfers := setupBatch(t, 5)
+
+	path := filepath.Join(t.TempDir(), "batch.journal")
+	journal, err := OpenJournal(path, contractAddress, auth.From)
+	require.NoError(t, err, "Error opening journal")
+	defer journal.Close()
+
+	summary, err := SendBatch(context.Background(), client, auth, contractAddress, transfers, journal, testBatchOptions)
+	require.NoError(t, err, "Error sending batch")
+	require.Equal(t, 5, summary.Confirmed, "Wrong number of confirmed transfers")
+	require.Equal(t, big.NewInt(1500), summary.Total, "Wrong total")
+	requireBalances(t, contract, transfers)
+
+	for _, transfer := range transfers {
+		entry, ok := journal.Entry(transfer.Row)
+		require.True(t, ok, "Transfer should be recorded")
+		require.Equal(t, TransferConfirmed, entry.Status, "Wrong status of row %d", transfer.Row)
+		require.NotNil(t, entry.TxHash, "Transaction hash should be recorded")
+	}
+
+	// Running the batch again skips all transfers
+	summary, err = SendBatch(context.Background(), client, auth, contractAddress, transfers, journal, testBatchOptions)
+	require.NoError(t, err, "Error resuming batch")
+	require.Equal(t, 5, summary.Skipped, "All transfers should be skipped")
+	require.Equal(t, 0, summary.Confirmed, "No transfer should be sent")
+	requireBalances(t, contract, transfers)
+
+	// The journal cannot be used for the same batch on another contract,
+	// where no transfer was confirmed
+	_, err = OpenJournal(path, common.HexToAddress("0x1"), auth.From)
+	require.ErrorIs(t, err, ErrJournalMismatch, "Journal of another contract should raise an error")
+}
+
+// TestSendBatchResume tests resuming a batch, which was interrupted after
+// transactions were signed and recorded as pending.
+func TestSendBatchResume(t *testing.T) {
+	client, auth, contractAddress, contract, transfers := setupBatch(t, 3)
+
+	journal, err := OpenJournal(filepath.Join(t.TempDir(), "batch.journal"), contractAddress, auth.From)
+	require.NoError(t, err, "Error opening journal")
+	defer journal.Close()
+
+	// Record signed transactions for the first two rows, as if the batch
+	// was interrupted before confirming them.
+	var pending []*types.Transaction
+	for i, transfer := range transfers[:2] {
+		opts := *auth
+		opts.Nonce = big.NewInt(int64(i + 1))
+		opts.NoSend = true
+		tx, err := contract.Transfer(&opts, transfer.Recipient, transfer.Amount)
+		require.NoError(t, err, "Error signing transfer")
+		raw, err := tx.MarshalBinary()
+		require.NoError(t, err, "Error encoding transfer")
+
+		txHash := tx.Hash()
+		require.NoError(t, journal.Record(JournalEntry{
+			Row:       transfer.Row,
+			Recipient: transfer.Recipient,
+			Amount:    transfer.Amount,
+			Status:    TransferPending,
+			TxHash:    &txHash,
+			RawTx:     raw,
+		}))
+		pending = append(pending, tx)
+	}
+
+	// The first transaction was broadcast before the interruption
+	require.NoError(t, client.SendTransaction(context.Background(), pending[0]), "Error sending first transfer")
+	client.Commit()
+
+	// The nonce of the second transaction is used by another transaction,
+	// so the second transfer was never executed.
+	opts := *auth
+	opts.Nonce = big.NewInt(2)
+	_, err = contract.Approve(&opts, transfers[0].Recipient, big.NewInt(1))
+	require.NoError(t, err, "Error sending other transaction")
+	client.Commit()
+
+	summary, err := SendBatch(context.Background(), client, auth, contractAddress, transfers, journal, testBatchOptions)
+	require.NoError(t, err, "Error resuming batch")
+	require.Equal(t, 3, summary.Confirmed, "Wrong number of confirmed transfers")
+	requireBalances(t, contract, transfers)
+
+	entry, ok := journal.Entry(transfers[1].Row)
+	require.True(t, ok, "Transfer should be recorded")
+	require.NotEqual(t, pending[1].Hash(), *entry.TxHash, "Dropped transfer should be sent in a new transaction")
+}
+
+// TestSendBatchResumeMined tests resuming a batch, whose pending transfer
+// was executed, while the balance of the sender only covers the remaining
+// transfers.
+func TestSendBatchResumeMined(t *testing.T) {
+	client, auth, contractAddress, contract, transfers := setupBatch(t, 2)
+
+	journal, err := OpenJournal(filepath.Join(t.TempDir(), "batch.journal"), contractAddress, auth.From)
+	require.NoError(t, err, "Error opening journal")
+	defer journal.Close()
+
+	// The first transfer was executed, but the batch was interrupted before
+	// it was recorded as confirmed
+	opts := *auth
+	opts.Nonce = big.NewInt(1)
+	opts.NoSend = true
+	tx, err := contract.Transfer(&opts, transfers[0].Recipient, transfers[0].Amount)
+	require.NoError(t, err, "Error signing transfer")
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err, "Error encoding transfer")
+	txHash := tx.Hash()
+	require.NoError(t, journal.Record(JournalEntry{
+		Row:       transfers[0].Row,
+		Recipient: transfers[0].Recipient,
+		Amount:    transfers[0].Amount,
+		Status:    TransferPending,
+		TxHash:    &txHash,
+		RawTx:     raw,
+	}))
+	require.NoError(t, client.SendTransaction(context.Background(), tx), "Error sending first transfer")
+	client.Commit()
+
+	// Leave the sender only the amount of the second transfer
+	balance, err := contract.BalanceOf(nil, auth.From)
+	require.NoError(t, err, "Error getting balance")
+	opts = *auth
+	opts.Nonce = big.NewInt(2)
+	_, err = contract.Transfer(&opts, common.HexToAddress("0x1"), new(big.Int).Sub(balance, transfers[1].Amount))
+	require.NoError(t, err, "Error sending other transaction")
+	client.Commit()
+
+	total, err := ValidateBatch(client, contractAddress, auth.From, transfers, journal)
+	require.NoError(t, err, "Pending transfer should not be checked against the balance")
+	require.Equal(t, big.NewInt(300), total, "Pending transfer should be outstanding")
+
+	summary, err := SendBatch(context.Background(), client, auth, contractAddress, transfers, journal, testBatchOptions)
+	require.NoError(t, err, "Error resuming batch")
+	require.Equal(t, 2, summary.Confirmed, "Wrong number of confirmed transfers")
+	requireBalances(t, contract, transfers)
+
+	entry, ok := journal.Entry(transfers[0].Row)
+	require.True(t, ok, "Transfer should be recorded")
+	require.Equal(t, txHash, *entry.TxHash, "Executed transfer should keep its transaction")
+}
+
+// knownTxClient is a simulated client, which broadcasts every transaction
+// but reports it as already known, like a node, whose response to the
+// broadcast was lost and which received the transaction again.
+type knownTxClient struct {
+	*SimulatedClient
+}
+
+func (c *knownTxClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := c.SimulatedClient.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	return errors.New("already known")
+}
+
+// TestSendBatchAlreadyKnown tests, that transfers, which the node reports
+// as already known, are awaited with the recorded transaction instead of
+// being signed again.
+func TestSendBatchAlreadyKnown(t *testing.T) {
+	client, auth, contractAddress, contract, transfers := setupBatch(t, 3)
+
+	journal, err := OpenJournal(filepath.Join(t.TempDir(), "batch.journal"), contractAddress, auth.From)
+	require.NoError(t, err, "Error opening journal")
+	defer journal.Close()
+
+	var recorded []JournalEntry
+	opts := testBatchOptions
+	opts.OnUpdate = func(entry JournalEntry) { recorded = append(recorded, entry) }
+	summary, err := SendBatch(context.Background(), &knownTxClient{client}, auth, contractAddress, transfers, journal, opts)
+	require.NoError(t, err, "Error sending batch")
+	require.Equal(t, 3, summary.Confirmed, "Wrong number of confirmed transfers")
+	requireBalances(t, contract, transfers)
+
+	for _, transfer := range transfers {
+		var hashes []common.Hash
+		for _, entry := range recorded {
+			if entry.Row == transfer.Row {
+				hashes = append(hashes, *entry.TxHash)
+			}
+		}
+		require.Len(t, hashes, 2, "Transfer of row %d should be recorded as pending and confirmed", transfer.Row)
+		require.Equal(t, hashes[0], hashes[1], "Transfer of row %d should not be signed again", transfer.Row)
+	}
+}
+
+// TestSendBatchValidation tests, that batches are validated before any
+// transfer is sent.
+func TestSendBatchValidation(t *testing.T) {
+	client, auth, contractAddress, contract, transfers := setupBatch(t, 2)
+
+	journal, err := OpenJournal(filepath.Join(t.TempDir(), "batch.journal"), contractAddress, auth.From)
+	require.NoError(t, err, "Error opening journal")
+	defer journal.Close()
+
+	// The total exceeds the balance of the sender
+	tooMuch := []BatchTransfer{transfers[0], {Row: 5, Recipient: transfers[1].Recipient, Amount: new(big.Int).Mul(big.NewInt(10000), Ten18)}}
+	_, err = SendBatch(context.Background(), client, auth, contractAddress, tooMuch, journal, testBatchOptions)
+	require.ErrorIs(t, err, ErrInsufficientBalance, "Exceeding total should raise an error")
+
+	// The journal records a different transfer for a row
+	require.NoError(t, journal.Record(JournalEntry{Row: transfers[0].Row, Recipient: auth.From, Amount: big.NewInt(1), Status: TransferConfirmed}))
+	_, err = SendBatch(context.Background(), client, auth, contractAddress, transfers, journal, testBatchOptions)
+	require.ErrorIs(t, err, ErrJournalMismatch, "Mismatching journal should raise an error")
+
+	balance, err := contract.BalanceOf(nil, transfers[0].Recipient)
+	require.NoError(t, err, "Error getting balance")
+	require.Zero(t, balance.Sign(), "No transfer should have been sent")
+}
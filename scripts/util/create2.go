@@ -0,0 +1,212 @@
+// create2.go contains the deterministic deployment of contracts with CREATE2
+// through the Create2Factory contract. The address of such a deployment only
+// depends on the factory address, the salt and the init code, but not on the
+// nonce of the deployer.
+package util
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrCreate2AddressMismatch is returned, when the factory deployed a contract
+// at another address than the computed CREATE2 address.
+var ErrCreate2AddressMismatch = errors.New("deployed address differs from the CREATE2 address")
+
+// Create2Deployment describes the deployment of init code through the
+// factory with a salt.
+type Create2Deployment struct {
+	Factory      common.Address
+	Salt         common.Hash
+	InitCodeHash common.Hash
+	// Address is the CREATE2 address, which is computed offline.
+	Address common.Address
+	// Existing is true, if there already was code at the address, so that no
+	// transaction was sent.
+	Existing bool
+	// Transaction is the deployment transaction, if one was sent.
+	Transaction *types.Transaction
+}
+
+// ParseSalt returns the CREATE2 salt for the given value. A 0x-prefixed hex
+// string of 32 bytes is used as is, while any other value is hashed with
+// keccak256, so that human-readable salts like "maltcoin-v1" can be used.
+func ParseSalt(value string) (common.Hash, error) {
+	if value == "" {
+		return common.Hash{}, errors.New("empty salt")
+	}
+	if !strings.HasPrefix(value, "0x") {
+		return crypto.Keccak256Hash([]byte(value)), nil
+	}
+
+	bz, err := hex.DecodeString(value[2:])
+	if err != nil || len(bz) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid salt %q: expected 32 bytes as hex", value)
+	}
+
+	return common.BytesToHash(bz), nil
+}
+
+// Create2Address computes the address, at which the factory deploys the given
+// init code with the given salt:
+// keccak256(0xff ++ factory ++ salt ++ keccak256(initCode))[12:].
+func Create2Address(factory common.Address, salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(factory, salt, crypto.Keccak256(initCode))
+}
+
+// GetFactoryABI returns the parsed ABI of the Create2Factory contract.
+func GetFactoryABI() (*abi.ABI, error) {
+	return maltcoin.Create2FactoryMetaData.GetAbi()
+}
+
+// DeployFactory fills the transaction signer fields for the deployment of
+// the Create2Factory contract and deploys it. The transaction is committed,
+// if the given backend is a simulated backend. Deploying the factory with
+// the same key and nonce on every network results in the same factory and
+// thus the same CREATE2 addresses.
+func DeployFactory(auth *bind.TransactOpts, backend Backend, feeOpts FeeOptions) (common.Address, *types.Transaction, error) {
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   nil,
+		Data: common.FromHex(maltcoin.Create2FactoryMetaData.Bin),
+	}
+	auth, err := FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	factoryAddress, tx, _, err := maltcoin.DeployCreate2Factory(auth, backend)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	// Commit transaction on simulated backend
+	Commit(backend)
+
+	return factoryAddress, tx, nil
+}
+
+// PrepareCreate2 computes the address, at which the factory deploys the
+// given init code, i.e. the creation bytecode including the constructor
+// arguments, with the given salt. If there already is code at the address,
+// the deployment is marked as existing. Otherwise, the transaction signer
+// fields are filled for the call of the factory, without sending it.
+func PrepareCreate2(ctx context.Context, auth *bind.TransactOpts, backend Backend, factoryAddress common.Address, salt common.Hash, initCode []byte, feeOpts FeeOptions) (*Create2Deployment, error) {
+	deployment := &Create2Deployment{
+		Factory:      factoryAddress,
+		Salt:         salt,
+		InitCodeHash: crypto.Keccak256Hash(initCode),
+		Address:      Create2Address(factoryAddress, salt, initCode),
+	}
+
+	code, err := backend.CodeAt(ctx, deployment.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) > 0 {
+		deployment.Existing = true
+		return deployment, nil
+	}
+
+	factoryCode, err := backend.CodeAt(ctx, factoryAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(factoryCode) == 0 {
+		return nil, fmt.Errorf("factory not deployed: %w %s", ErrNoContractCode, factoryAddress)
+	}
+
+	factoryABI, err := GetFactoryABI()
+	if err != nil {
+		return nil, err
+	}
+	callData, err := factoryABI.Pack("deploy", salt, initCode)
+	if err != nil {
+		return nil, err
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   &factoryAddress,
+		Data: callData,
+	}
+	if _, err := FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts); err != nil {
+		return nil, err
+	}
+
+	return deployment, nil
+}
+
+// DeployCreate2 prepares the deployment of the given init code through the
+// factory with the given salt like PrepareCreate2 and sends it, unless the
+// contract already exists. The transaction is committed, if the given
+// backend is a simulated backend.
+func DeployCreate2(ctx context.Context, auth *bind.TransactOpts, backend Backend, factoryAddress common.Address, salt common.Hash, initCode []byte, feeOpts FeeOptions) (*Create2Deployment, error) {
+	deployment, err := PrepareCreate2(ctx, auth, backend, factoryAddress, salt, initCode, feeOpts)
+	if err != nil || deployment.Existing {
+		return deployment, err
+	}
+
+	factory, err := maltcoin.NewCreate2Factory(factoryAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := factory.Deploy(auth, salt, initCode)
+	if err != nil {
+		return nil, err
+	}
+	deployment.Transaction = tx
+
+	// Commit transaction on simulated backend
+	Commit(backend)
+
+	return deployment, nil
+}
+
+// VerifyCreate2Deployment checks, that the Deployed event of the factory in
+// the given receipt reports the computed address and that there is code at
+// the address.
+func VerifyCreate2Deployment(ctx context.Context, backend bind.ContractBackend, deployment *Create2Deployment, receipt *types.Receipt) error {
+	factory, err := maltcoin.NewCreate2Factory(deployment.Factory, backend)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, log := range receipt.Logs {
+		if log.Address != deployment.Factory {
+			continue
+		}
+		event, err := factory.ParseDeployed(*log)
+		if err != nil {
+			continue
+		}
+		if event.Addr != deployment.Address {
+			return fmt.Errorf("%w: factory deployed %s instead of %s", ErrCreate2AddressMismatch, event.Addr, deployment.Address)
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("no Deployed event of factory %s in transaction %s", deployment.Factory, receipt.TxHash.Hex())
+	}
+
+	code, err := backend.CodeAt(ctx, deployment.Address, nil)
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("%w %s", ErrNoContractCode, deployment.Address)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+// create2_test.go contains the unit tests for deploying contracts with
+// CREATE2 through the factory contract.
+package util
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseSalt tests parsing hex and human-readable salts.
+func TestParseSalt(t *testing.T) {
+	hexSalt := "0x" + common.Bytes2Hex(common.LeftPadBytes([]byte{0x2a}, 32))
+
+	testcases := []struct {
+		name   string
+		value  string
+		exp    common.Hash
+		expErr bool
+	}{
+		{"passes - 32 bytes hex", hexSalt, common.BigToHash(big.NewInt(42)), false},
+		{"passes - text is hashed", "maltcoin-v1", crypto.Keccak256Hash([]byte("maltcoin-v1")), false},
+		{"fails - empty", "", common.Hash{}, true},
+		{"fails - short hex", "0x2a", common.Hash{}, true},
+		{"fails - invalid hex", "0x" + hexSalt[4:] + "zz", common.Hash{}, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			salt, err := ParseSalt(tc.value)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, salt)
+		})
+	}
+}
+
+// TestCreate2Address tests computing CREATE2 addresses offline.
+func TestCreate2Address(t *testing.T) {
+	// Example 1 of EIP-1014
+	require.Equal(t,
+		common.HexToAddress("0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"),
+		Create2Address(common.Address{}, common.Hash{}, []byte{0x00}),
+	)
+
+	privKeys, _, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private key")
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting client and transaction signer")
+	factoryAddress, _, err := DeployFactory(auth, client, DefaultFeeOptions())
+	require.NoError(t, err, "Error deploying factory")
+
+	factory, err := maltcoin.NewCreate2Factory(factoryAddress, client)
+	require.NoError(t, err)
+	initCode, err := GetDeployData(DefaultTokenSettings().Args()...)
+	require.NoError(t, err)
+	salt := crypto.Keccak256Hash([]byte("salt"))
+	onChain, err := factory.ComputeAddress(nil, salt, crypto.Keccak256Hash(initCode))
+	require.NoError(t, err)
+	require.Equal(t, onChain, Create2Address(factoryAddress, salt, initCode), "Address should match the factory")
+}
+
+// TestDeployCreate2 tests deploying the token contract through the factory
+// on a simulated backend.
+func TestDeployCreate2(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+
+	// Both accounts have funds, so that the second one can send the
+	// deployment of the first one
+	client := &SimulatedClient{backends.NewSimulatedBackend(core.GenesisAlloc{
+		addresses[0]: {Balance: new(big.Int).Mul(big.NewInt(1000), Ten18)},
+		addresses[1]: {Balance: new(big.Int).Mul(big.NewInt(1000), Ten18)},
+	}, MaxGasPerBlock)}
+	auth, err := NewTransactionSigner(client, privKeys[0])
+	require.NoError(t, err, "Error creating transaction signer")
+	other, err := NewTransactionSigner(client, privKeys[1])
+	require.NoError(t, err, "Error creating transaction signer")
+	factoryAddress, _, err := DeployFactory(auth, client, DefaultFeeOptions())
+	require.NoError(t, err, "Error deploying factory")
+
+	ctx := context.Background()
+	settings := DefaultTokenSettings()
+	settings.InitialHolder = addresses[0]
+	initCode, err := GetDeployData(settings.Args()...)
+	require.NoError(t, err)
+	salt := crypto.Keccak256Hash([]byte("maltcoin-v1"))
+
+	// Another account sends the same deployment, e.g. by copying it from
+	// the transaction pool, which still assigns the supply to the holder
+	deployment, err := DeployCreate2(ctx, other, client, factoryAddress, salt, initCode, DefaultFeeOptions())
+	require.NoError(t, err, "Error deploying contract")
+	require.False(t, deployment.Existing)
+	require.NotNil(t, deployment.Transaction)
+	require.Equal(t, Create2Address(factoryAddress, salt, initCode), deployment.Address)
+
+	receipt, err := client.TransactionReceipt(ctx, deployment.Transaction.Hash())
+	require.NoError(t, err, "Error getting receipt")
+	require.NoError(t, VerifyCreate2Deployment(ctx, client, deployment, receipt), "Deployment should be verified")
+
+	contract, err := GetContract(client, deployment.Address)
+	require.NoError(t, err, "Error loading deployed contract")
+	totalSupply, err := contract.TotalSupply(nil)
+	require.NoError(t, err)
+	balance, err := contract.BalanceOf(nil, addresses[0])
+	require.NoError(t, err)
+	require.Equal(t, totalSupply, balance, "Initial supply should be assigned to the holder")
+	for _, address := range []common.Address{addresses[1], factoryAddress} {
+		balance, err := contract.BalanceOf(nil, address)
+		require.NoError(t, err)
+		require.Zero(t, balance.Sign(), "Sender and factory should not receive tokens")
+	}
+
+	// A second deployment with the same salt finds the existing contract
+	again, err := DeployCreate2(ctx, auth, client, factoryAddress, salt, initCode, DefaultFeeOptions())
+	require.NoError(t, err)
+	require.True(t, again.Existing, "Existing deployment should be detected")
+	require.Nil(t, again.Transaction, "No transaction should be sent")
+	require.Equal(t, deployment.Address, again.Address)
+
+	// The address check fails for another address
+	wrong := *deployment
+	wrong.Address = addresses[0]
+	require.ErrorIs(t, VerifyCreate2Deployment(ctx, client, &wrong, receipt), ErrCreate2AddressMismatch)
+
+	// The factory has to be deployed
+	_, err = DeployCreate2(ctx, auth, client, addresses[0], crypto.Keccak256Hash([]byte("other")), initCode, DefaultFeeOptions())
+	require.ErrorIs(t, err, ErrNoContractCode)
+}
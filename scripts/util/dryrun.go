@@ -0,0 +1,147 @@
+// dryrun.go contains the estimation of transactions, which are prepared but
+// not sent. It predicts the address of deployed contracts, the gas and the
+// maximum cost in the native token and checks the balance of the sender.
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrInsufficientFunds is returned, when the native balance of the sender
+// can't cover the maximum cost of a transaction.
+var ErrInsufficientFunds = errors.New("insufficient funds for gas * price + value")
+
+// NativeUnit is the unit of the native token of Evmos, which is used to
+// pay the transaction fees.
+var NativeUnit = TokenUnit{Symbol: "EVMOS", Decimals: 18}
+
+// BalanceBackend defines an interface, which can be used to get the native
+// balance of an account for a given ethclient or simulated backend.
+type BalanceBackend interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// DryRunBackend defines the methods, which are needed to prepare and
+// estimate a transaction without sending it.
+type DryRunBackend interface {
+	Backend
+	BalanceBackend
+}
+
+// TransactionEstimate contains the predicted nonce, gas and cost of a
+// transaction, for which the transaction signer fields were filled.
+type TransactionEstimate struct {
+	From     common.Address
+	Nonce    uint64
+	GasLimit uint64
+	// FeePerGas is the gas price of legacy transactions or the fee cap of
+	// dynamic fee transactions, i.e. the maximum price paid per gas.
+	FeePerGas *big.Int
+	Value     *big.Int
+	// Cost is the maximum cost of the transaction: GasLimit * FeePerGas + Value.
+	Cost    *big.Int
+	Balance *big.Int
+	// ContractAddress is the address of the contract, which is created by a
+	// deployment. It is nil for other transactions.
+	ContractAddress *common.Address
+}
+
+// Sufficient reports, if the balance of the sender covers the cost.
+func (e *TransactionEstimate) Sufficient() bool {
+	return e.Balance.Cmp(e.Cost) >= 0
+}
+
+// Err returns ErrInsufficientFunds, if the balance of the sender does not
+// cover the cost.
+func (e *TransactionEstimate) Err() error {
+	if e.Sufficient() {
+		return nil
+	}
+
+	return fmt.Errorf("%w: balance %v of %s is less than the cost of %v", ErrInsufficientFunds,
+		NewTokenAmount(e.Balance, NativeUnit), e.From, NewTokenAmount(e.Cost, NativeUnit))
+}
+
+// EstimateTransaction returns the estimate of the transaction, for which the
+// given transaction signer was filled with FillTransactionSignerFields, and
+// queries the native balance of the sender.
+func EstimateTransaction(ctx context.Context, backend BalanceBackend, auth *bind.TransactOpts) (*TransactionEstimate, error) {
+	if auth.Nonce == nil {
+		return nil, errors.New("transaction signer fields are not filled")
+	}
+
+	feePerGas := auth.GasPrice
+	if auth.GasFeeCap != nil {
+		feePerGas = auth.GasFeeCap
+	}
+	value := auth.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(auth.GasLimit), feePerGas)
+	cost.Add(cost, value)
+
+	balance, err := backend.BalanceAt(ctx, auth.From, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve balance: %w", err)
+	}
+
+	return &TransactionEstimate{
+		From:      auth.From,
+		Nonce:     auth.Nonce.Uint64(),
+		GasLimit:  auth.GasLimit,
+		FeePerGas: feePerGas,
+		Value:     value,
+		Cost:      cost,
+		Balance:   balance,
+	}, nil
+}
+
+// EstimateDeployment fills the transaction signer fields for the deployment
+// of the ERC20 token contract with the given settings like DeployContract,
+// but returns the estimate instead of sending the transaction. The contract
+// address is predicted from the address and nonce of the deployer.
+func EstimateDeployment(auth *bind.TransactOpts, backend DryRunBackend, settings TokenSettings, feeOpts FeeOptions) (*TransactionEstimate, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	deployData, err := GetDeployData(settings.Args()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return estimateDeployment(auth, backend, deployData, feeOpts)
+}
+
+// estimateDeployment fills the transaction signer fields for the deployment
+// with the given deploy data and returns its estimate together with the
+// address of the contract.
+func estimateDeployment(auth *bind.TransactOpts, backend DryRunBackend, deployData []byte, feeOpts FeeOptions) (*TransactionEstimate, error) {
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   nil,
+		Data: deployData,
+	}
+	auth, err := FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate, err := EstimateTransaction(context.Background(), backend, auth)
+	if err != nil {
+		return nil, err
+	}
+	contractAddress := crypto.CreateAddress(auth.From, estimate.Nonce)
+	estimate.ContractAddress = &contractAddress
+
+	return estimate, nil
+}
@@ -0,0 +1,106 @@
+// dryrun_test.go contains the unit tests for estimating transactions
+// without sending them.
+package util
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEstimateDeployment tests, that the estimate of a deployment predicts
+// the contract address and gas of the actual deployment.
+func TestEstimateDeployment(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private key")
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting client and transaction signer")
+	ctx := context.Background()
+
+	estimate, err := EstimateDeployment(auth, client, DefaultTokenSettings(), DefaultFeeOptions())
+	require.NoError(t, err, "Error estimating deployment")
+	require.Equal(t, addresses[0], estimate.From)
+	require.NotNil(t, estimate.ContractAddress, "Contract address should be predicted")
+	require.True(t, estimate.Sufficient(), "Funded account should cover the cost")
+	require.NoError(t, estimate.Err())
+	expCost := new(big.Int).Mul(new(big.Int).SetUint64(estimate.GasLimit), estimate.FeePerGas)
+	require.Equal(t, expCost, estimate.Cost, "Cost should be gas times fee per gas")
+
+	nonce, err := client.PendingNonceAt(ctx, addresses[0])
+	require.NoError(t, err)
+	require.Equal(t, estimate.Nonce, nonce, "No transaction should be sent")
+
+	contractAddress, tx, _, err := DeployContract(auth, client, DefaultTokenSettings(), DefaultFeeOptions())
+	require.NoError(t, err, "Error deploying contract")
+	require.Equal(t, *estimate.ContractAddress, contractAddress, "Predicted address should match")
+	receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	require.LessOrEqual(t, receipt.GasUsed, estimate.GasLimit, "Gas used should not exceed the estimate")
+}
+
+// TestEstimateTransaction tests estimating a token transfer, for which the
+// transaction signer fields were filled, without sending it.
+func TestEstimateTransaction(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting client and transaction signer")
+	contractAddress, _, contract, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+	ctx := context.Background()
+
+	_, err = EstimateTransaction(ctx, client, &bind.TransactOpts{From: addresses[0]})
+	require.Error(t, err, "Unfilled transaction signer should raise an error")
+
+	nonce, err := client.PendingNonceAt(ctx, addresses[0])
+	require.NoError(t, err)
+	callData, err := GetCallData("transfer", addresses[1], big.NewInt(100))
+	require.NoError(t, err)
+	callMsg := ethereum.CallMsg{From: auth.From, To: &contractAddress, Data: callData}
+	auth, err = FillTransactionSignerFieldsWithFees(auth, client, callMsg, DefaultFeeOptions())
+	require.NoError(t, err, "Error filling transaction signer fields")
+	estimate, err := EstimateTransaction(ctx, client, auth)
+	require.NoError(t, err, "Error estimating transfer")
+	require.Nil(t, estimate.ContractAddress, "Transfer should not create a contract")
+	require.Equal(t, nonce, estimate.Nonce)
+	require.Positive(t, estimate.GasLimit)
+
+	client.Commit()
+	pending, err := client.PendingNonceAt(ctx, addresses[0])
+	require.NoError(t, err)
+	require.Equal(t, nonce, pending, "No transaction should be sent")
+	balance, err := contract.BalanceOf(nil, addresses[1])
+	require.NoError(t, err)
+	require.Zero(t, balance.Sign(), "Recipient should not receive tokens")
+}
+
+// TestTransactionEstimateErr tests the check of the native balance.
+func TestTransactionEstimateErr(t *testing.T) {
+	testcases := []struct {
+		name    string
+		balance int64
+		expErr  bool
+	}{
+		{"passes - balance exceeds cost", 101, false},
+		{"passes - balance equals cost", 100, false},
+		{"fails - balance below cost", 99, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			estimate := &TransactionEstimate{Cost: big.NewInt(100), Balance: big.NewInt(tc.balance)}
+			if tc.expErr {
+				require.ErrorIs(t, estimate.Err(), ErrInsufficientFunds)
+			} else {
+				require.NoError(t, estimate.Err())
+			}
+		})
+	}
+
+	_, err := EstimateTransaction(context.Background(), nil, &bind.TransactOpts{})
+	require.Error(t, err, "Unfilled transaction signer should be rejected")
+}
@@ -0,0 +1,370 @@
+// eventstore.go contains the storage of indexed token events in an embedded
+// LevelDB database. Events are stored by block and log index and can be
+// looked up by address, block range and transaction.
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	lvlutil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// DefaultEventStoreDir is the directory of the event store, if no other
+// directory is configured.
+const DefaultEventStoreDir = "eventdb"
+
+// Event types of indexed token events
+const (
+	EventTransfer = "Transfer"
+	EventApproval = "Approval"
+)
+
+// Key prefixes of the event store
+var (
+	eventPrefix    = []byte("e")
+	addressPrefix  = []byte("a")
+	txPrefix       = []byte("t")
+	contractKey    = []byte("m/contract")
+	progressKey    = []byte("m/progress")
+	unitKey        = []byte("m/unit")
+	eventKeyLength = 1 + 8 + 4
+)
+
+var (
+	// ErrStoreMismatch is returned, when an event store is opened for another
+	// contract than the one it contains the events of.
+	ErrStoreMismatch = errors.New("event store belongs to another contract")
+	// ErrNotIndexed is returned, when an event store is opened for reading,
+	// which does not exist yet, because no events were indexed.
+	ErrNotIndexed = errors.New("no events indexed")
+)
+
+// IndexedEvent is a Transfer or Approval event of the token. For approvals,
+// From is the owner and To the spender of the allowance.
+type IndexedEvent struct {
+	Type        string         `json:"type"`
+	BlockNumber uint64         `json:"block_number"`
+	BlockHash   common.Hash    `json:"block_hash"`
+	TxHash      common.Hash    `json:"tx_hash"`
+	TxIndex     uint           `json:"tx_index"`
+	LogIndex    uint           `json:"log_index"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Value       *big.Int       `json:"value"`
+}
+
+// EventStore stores the indexed events of a token contract in a LevelDB
+// database together with the progress of the indexer.
+type EventStore struct {
+	db       *leveldb.DB
+	contract common.Address
+}
+
+// OpenEventStore opens the event store in the given directory, which is
+// created on demand. A store can only hold the events of a single contract.
+func OpenEventStore(dir string, contractAddress common.Address) (*EventStore, error) {
+	return openEventStore(dir, contractAddress, false)
+}
+
+// OpenEventStoreReadOnly opens the existing event store in the given
+// directory for queries. Unlike OpenEventStore, it neither creates the
+// directory nor assigns the store to the contract, but returns
+// ErrNotIndexed, if no events of the contract were indexed yet.
+func OpenEventStoreReadOnly(dir string, contractAddress common.Address) (*EventStore, error) {
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w in %s, run the index command first", ErrNotIndexed, dir)
+	}
+
+	return openEventStore(dir, contractAddress, true)
+}
+
+// openEventStore opens the event store and checks, that it belongs to the
+// contract. A writable store without a contract is assigned to it.
+func openEventStore(dir string, contractAddress common.Address, readOnly bool) (*EventStore, error) {
+	db, err := leveldb.OpenFile(dir, &opt.Options{ReadOnly: readOnly, ErrorIfMissing: readOnly})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event store %s: %w", dir, err)
+	}
+
+	stored, err := db.Get(contractKey, nil)
+	switch {
+	case errors.Is(err, leveldb.ErrNotFound) && readOnly:
+		err = fmt.Errorf("%w in %s, run the index command first", ErrNotIndexed, dir)
+	case errors.Is(err, leveldb.ErrNotFound):
+		err = db.Put(contractKey, contractAddress.Bytes(), nil)
+	case err == nil && common.BytesToAddress(stored) != contractAddress:
+		err = fmt.Errorf("%w %s", ErrStoreMismatch, common.BytesToAddress(stored))
+	}
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &EventStore{db: db, contract: contractAddress}, nil
+}
+
+// Contract returns the address of the contract, whose events are stored.
+func (s *EventStore) Contract() common.Address {
+	return s.contract
+}
+
+// Close closes the database.
+func (s *EventStore) Close() error {
+	return s.db.Close()
+}
+
+// Progress returns the last block, up to which all events are stored. The
+// second return value is false, if no block was indexed yet.
+func (s *EventStore) Progress() (uint64, bool, error) {
+	bz, err := s.db.Get(progressKey, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return binary.BigEndian.Uint64(bz), true, nil
+}
+
+// SetTokenUnit stores the symbol and decimals of the token, so that the
+// stored amounts can be displayed without a connection to a node.
+func (s *EventStore) SetTokenUnit(unit TokenUnit) error {
+	unit.Raw = false
+	bz, err := json.Marshal(unit)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(unitKey, bz, nil)
+}
+
+// TokenUnit returns the stored unit of the token. The second return value
+// is false, if no unit was stored yet.
+func (s *EventStore) TokenUnit() (TokenUnit, bool, error) {
+	bz, err := s.db.Get(unitKey, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return TokenUnit{}, false, nil
+	}
+	if err != nil {
+		return TokenUnit{}, false, err
+	}
+
+	var unit TokenUnit
+	if err := json.Unmarshal(bz, &unit); err != nil {
+		return TokenUnit{}, false, err
+	}
+
+	return unit, true, nil
+}
+
+// Store replaces the events in the given range of blocks including both
+// ends with the given events and advances the progress to the last block of
+// the range in one atomic batch, so that the progress always matches the
+// stored events. Stored events, which are not given again, are deleted,
+// because their blocks were removed by a reorganization, after the events
+// were stored with Put.
+func (s *EventStore) Store(from, to uint64, events []IndexedEvent) error {
+	stored, err := s.EventsInBlocks(from, to)
+	if err != nil {
+		return err
+	}
+
+	// Events, which are given again, are deleted and written again in the
+	// same batch, which applies the operations in order
+	batch := new(leveldb.Batch)
+	for _, event := range stored {
+		s.delete(batch, event)
+	}
+	for _, event := range events {
+		if err := s.put(batch, event); err != nil {
+			return err
+		}
+	}
+	batch.Put(progressKey, encodeUint64(to))
+
+	return s.db.Write(batch, nil)
+}
+
+// Put writes the events without changing the progress.
+func (s *EventStore) Put(events ...IndexedEvent) error {
+	batch := new(leveldb.Batch)
+	for _, event := range events {
+		if err := s.put(batch, event); err != nil {
+			return err
+		}
+	}
+
+	return s.db.Write(batch, nil)
+}
+
+// put adds the event and its index entries to the batch.
+func (s *EventStore) put(batch *leveldb.Batch, event IndexedEvent) error {
+	bz, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	key := eventKey(event.BlockNumber, event.LogIndex)
+	batch.Put(key, bz)
+	for _, address := range []common.Address{event.From, event.To} {
+		batch.Put(concat(addressPrefix, address.Bytes(), key[1:]), nil)
+	}
+	batch.Put(concat(txPrefix, event.TxHash.Bytes(), encodeUint32(uint32(event.LogIndex))), key)
+
+	return nil
+}
+
+// Delete removes the event at the given position and its index entries,
+// e.g. because its block was removed by a reorganization.
+func (s *EventStore) Delete(blockNumber uint64, logIndex uint) error {
+	key := eventKey(blockNumber, logIndex)
+	bz, err := s.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var event IndexedEvent
+	if err := json.Unmarshal(bz, &event); err != nil {
+		return err
+	}
+
+	batch := new(leveldb.Batch)
+	s.delete(batch, event)
+
+	return s.db.Write(batch, nil)
+}
+
+// delete adds the removal of the event and its index entries to the batch.
+func (s *EventStore) delete(batch *leveldb.Batch, event IndexedEvent) {
+	key := eventKey(event.BlockNumber, event.LogIndex)
+	batch.Delete(key)
+	for _, address := range []common.Address{event.From, event.To} {
+		batch.Delete(concat(addressPrefix, address.Bytes(), key[1:]))
+	}
+	batch.Delete(concat(txPrefix, event.TxHash.Bytes(), encodeUint32(uint32(event.LogIndex))))
+}
+
+// EventsInBlocks returns the events in the given range of blocks including
+// both ends in the order of their execution.
+func (s *EventStore) EventsInBlocks(from, to uint64) ([]IndexedEvent, error) {
+	if to < from {
+		return nil, nil
+	}
+	start := eventKey(from, 0)
+	limit := concat(eventPrefix, encodeUint64(to+1))
+	if to == ^uint64(0) {
+		limit = lvlutil.BytesPrefix(eventPrefix).Limit
+	}
+
+	iter := s.db.NewIterator(&lvlutil.Range{Start: start, Limit: limit}, nil)
+	defer iter.Release()
+
+	var events []IndexedEvent
+	for iter.Next() {
+		var event IndexedEvent
+		if err := json.Unmarshal(iter.Value(), &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, iter.Error()
+}
+
+// EventsByAddress returns all events, which the given address sent or
+// received, or in which it is the owner or spender of an allowance.
+func (s *EventStore) EventsByAddress(address common.Address) ([]IndexedEvent, error) {
+	prefix := concat(addressPrefix, address.Bytes())
+	iter := s.db.NewIterator(lvlutil.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	var keys [][]byte
+	for iter.Next() {
+		keys = append(keys, concat(eventPrefix, iter.Key()[len(prefix):]))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	return s.events(keys)
+}
+
+// EventsByTx returns the events emitted by the given transaction.
+func (s *EventStore) EventsByTx(txHash common.Hash) ([]IndexedEvent, error) {
+	iter := s.db.NewIterator(lvlutil.BytesPrefix(concat(txPrefix, txHash.Bytes())), nil)
+	defer iter.Release()
+
+	var keys [][]byte
+	for iter.Next() {
+		keys = append(keys, common.CopyBytes(iter.Value()))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	return s.events(keys)
+}
+
+// events loads the events with the given keys.
+func (s *EventStore) events(keys [][]byte) ([]IndexedEvent, error) {
+	events := make([]IndexedEvent, 0, len(keys))
+	for _, key := range keys {
+		if len(key) != eventKeyLength || !bytes.HasPrefix(key, eventPrefix) {
+			return nil, fmt.Errorf("invalid event key %x", key)
+		}
+		bz, err := s.db.Get(key, nil)
+		if err != nil {
+			return nil, err
+		}
+		var event IndexedEvent
+		if err := json.Unmarshal(bz, &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// eventKey returns the primary key of an event, which orders the events
+// by block and log index.
+func eventKey(blockNumber uint64, logIndex uint) []byte {
+	return concat(eventPrefix, encodeUint64(blockNumber), encodeUint32(uint32(logIndex)))
+}
+
+// encodeUint64 encodes the number in big endian order, so that the keys
+// are sorted numerically.
+func encodeUint64(n uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, n)
+	return bz
+}
+
+// encodeUint32 encodes the number in big endian order.
+func encodeUint32(n uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, n)
+	return bz
+}
+
+// concat joins the given byte slices into a new slice.
+func concat(parts ...[]byte) []byte {
+	var result []byte
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+// eventstore_test.go contains the unit tests for the storage of indexed
+// token events.
+package util
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEventStore tests storing, querying and deleting events.
+func TestEventStore(t *testing.T) {
+	contractAddress := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	alice := common.HexToAddress("0x0000000000000000000000000000000000000a11")
+	bob := common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	carol := common.HexToAddress("0x0000000000000000000000000000000000000ca7")
+	txA, txB := common.HexToHash("0xaa"), common.HexToHash("0xbb")
+
+	dir := t.TempDir()
+	store, err := OpenEventStore(dir, contractAddress)
+	require.NoError(t, err, "Error opening event store")
+
+	_, ok, err := store.Progress()
+	require.NoError(t, err, "Error getting progress")
+	require.False(t, ok, "New store should have no progress")
+
+	events := []IndexedEvent{
+		{Type: EventTransfer, BlockNumber: 300, TxHash: txB, LogIndex: 0, From: bob, To: carol, Value: big.NewInt(3)},
+		{Type: EventTransfer, BlockNumber: 2, TxHash: txA, LogIndex: 0, From: alice, To: bob, Value: big.NewInt(1)},
+		{Type: EventApproval, BlockNumber: 2, TxHash: txA, LogIndex: 1, From: alice, To: carol, Value: big.NewInt(2)},
+	}
+	require.NoError(t, store.Store(0, 400, events), "Error storing events")
+
+	// Storing events again does not duplicate them
+	require.NoError(t, store.Store(300, 400, events[:1]), "Error storing events again")
+
+	testcases := []struct {
+		name     string
+		query    func() ([]IndexedEvent, error)
+		expected []IndexedEvent
+	}{
+		{"all blocks", func() ([]IndexedEvent, error) { return store.EventsInBlocks(0, ^uint64(0)) }, []IndexedEvent{events[1], events[2], events[0]}},
+		{"block range", func() ([]IndexedEvent, error) { return store.EventsInBlocks(3, 300) }, events[:1]},
+		{"empty block range", func() ([]IndexedEvent, error) { return store.EventsInBlocks(3, 299) }, nil},
+		{"address", func() ([]IndexedEvent, error) { return store.EventsByAddress(bob) }, []IndexedEvent{events[1], events[0]}},
+		{"spender", func() ([]IndexedEvent, error) { return store.EventsByAddress(carol) }, []IndexedEvent{events[2], events[0]}},
+		{"transaction", func() ([]IndexedEvent, error) { return store.EventsByTx(txA) }, events[1:]},
+		{"unknown transaction", func() ([]IndexedEvent, error) { return store.EventsByTx(common.HexToHash("0xcc")) }, []IndexedEvent{}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			found, err := tc.query()
+			require.NoError(t, err, "Error querying events")
+			require.Equal(t, tc.expected, found, "Wrong events")
+		})
+	}
+
+	// The progress and the events are kept after reopening the store
+	require.NoError(t, store.Close(), "Error closing event store")
+	_, err = OpenEventStore(dir, alice)
+	require.True(t, errors.Is(err, ErrStoreMismatch), "Expected a mismatch error, got %v", err)
+	store, err = OpenEventStore(dir, contractAddress)
+	require.NoError(t, err, "Error reopening event store")
+	defer store.Close()
+
+	progress, ok, err := store.Progress()
+	require.NoError(t, err, "Error getting progress")
+	require.True(t, ok, "Store should have progress")
+	require.Equal(t, uint64(400), progress, "Wrong progress")
+
+	_, ok, err = store.TokenUnit()
+	require.NoError(t, err, "Error getting token unit")
+	require.False(t, ok, "Store should have no token unit")
+	unit := TokenUnit{Symbol: "MALT", Decimals: 18}
+	require.NoError(t, store.SetTokenUnit(unit), "Error storing token unit")
+	storedUnit, ok, err := store.TokenUnit()
+	require.NoError(t, err, "Error getting token unit")
+	require.True(t, ok, "Store should have a token unit")
+	require.Equal(t, unit, storedUnit, "Wrong token unit")
+
+	// Deleting an event removes it from all indexes
+	require.NoError(t, store.Delete(2, 1), "Error deleting event")
+	found, err := store.EventsByAddress(carol)
+	require.NoError(t, err, "Error querying events")
+	require.Equal(t, events[:1], found, "Deleted approval should be removed")
+	found, err = store.EventsByTx(txA)
+	require.NoError(t, err, "Error querying events")
+	require.Equal(t, events[1:2], found, "Deleted approval should be removed")
+
+	// Storing a range again removes the events, which are not given again,
+	// e.g. because their block was reorganized after they were put
+	reorged := IndexedEvent{Type: EventTransfer, BlockNumber: 401, TxHash: common.HexToHash("0xdd"), LogIndex: 0, From: carol, To: alice, Value: big.NewInt(4)}
+	require.NoError(t, store.Put(reorged), "Error putting event")
+	require.NoError(t, store.Store(300, 401, events[:1]), "Error storing events")
+	found, err = store.EventsInBlocks(300, 401)
+	require.NoError(t, err, "Error querying events")
+	require.Equal(t, events[:1], found, "Reorganized event should be removed")
+	for _, query := range []func() ([]IndexedEvent, error){
+		func() ([]IndexedEvent, error) { return store.EventsByAddress(alice) },
+		func() ([]IndexedEvent, error) { return store.EventsByTx(reorged.TxHash) },
+	} {
+		found, err = query()
+		require.NoError(t, err, "Error querying events")
+		require.NotContains(t, found, reorged, "Index entries of reorganized event should be removed")
+	}
+	progress, _, err = store.Progress()
+	require.NoError(t, err, "Error getting progress")
+	require.Equal(t, uint64(401), progress, "Progress should advance to the end of the range")
+}
+
+// TestOpenEventStoreReadOnly tests, that queries neither create an event
+// store nor assign it to the contract.
+func TestOpenEventStoreReadOnly(t *testing.T) {
+	contractAddress := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	dir := filepath.Join(t.TempDir(), "eventdb")
+
+	_, err := OpenEventStoreReadOnly(dir, contractAddress)
+	require.ErrorIs(t, err, ErrNotIndexed, "Missing store should not be indexed")
+	_, err = os.Stat(dir)
+	require.True(t, errors.Is(err, os.ErrNotExist), "Store should not be created")
+
+	store, err := OpenEventStore(dir, contractAddress)
+	require.NoError(t, err, "Error opening event store")
+	require.NoError(t, store.Store(0, 10, nil), "Error storing progress")
+	require.NoError(t, store.Close())
+
+	_, err = OpenEventStoreReadOnly(dir, common.HexToAddress("0x1"))
+	require.ErrorIs(t, err, ErrStoreMismatch, "Store of another contract should not be opened")
+	store, err = OpenEventStoreReadOnly(dir, contractAddress)
+	require.NoError(t, err, "Error opening event store for reading")
+	defer store.Close()
+	progress, ok, err := store.Progress()
+	require.NoError(t, err)
+	require.True(t, ok, "Store should have progress")
+	require.Equal(t, uint64(10), progress)
+	require.Error(t, store.SetTokenUnit(TokenUnit{Symbol: "MALT", Decimals: 18}), "Read-only store should not be written")
+}
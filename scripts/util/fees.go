@@ -0,0 +1,146 @@
+// fees.go contains the functions to determine the transaction fees. Chains
+// with a London-style fee market, which is indicated by a base fee in the
+// block header, are served with EIP-1559 dynamic fee transactions, all other
+// chains with legacy gas prices.
+package util
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// GasStrategyLegacy uses the suggested gas price for all transactions.
+	GasStrategyLegacy = "legacy"
+
+	// GasStrategyAuto uses dynamic fees, if the latest block header contains
+	// a base fee, and legacy gas prices otherwise.
+	GasStrategyAuto = "auto"
+
+	// GasStrategyDynamic uses EIP-1559 dynamic fees and fails on chains
+	// without a base fee.
+	GasStrategyDynamic = "dynamic"
+
+	// DefaultBaseFeeMultiplier is the factor, by which the base fee is
+	// multiplied to define the fee cap. Using twice the base fee keeps the
+	// transaction valid for several blocks with rising base fees.
+	DefaultBaseFeeMultiplier = 2.0
+)
+
+// FeeBackend defines an interface, which can be used to suggest the fees
+// of a transaction for a given ethclient or simulated backend.
+type FeeBackend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// FeeOptions define how the fees of a transaction are determined.
+type FeeOptions struct {
+	// Strategy is one of the gas strategies auto, dynamic or legacy.
+	Strategy string
+	// BaseFeeMultiplier is the factor, by which the base fee is multiplied
+	// to calculate the fee cap of dynamic fee transactions.
+	BaseFeeMultiplier float64
+}
+
+// DefaultFeeOptions returns the fee options, which detect the fee market
+// of the chain.
+func DefaultFeeOptions() FeeOptions {
+	return FeeOptions{
+		Strategy:          GasStrategyAuto,
+		BaseFeeMultiplier: DefaultBaseFeeMultiplier,
+	}
+}
+
+// Fees contains the suggested fees for a transaction. Either the gas price
+// for legacy transactions, or the tip and fee caps for dynamic fee
+// transactions are set.
+type Fees struct {
+	GasPrice  *big.Int
+	BaseFee   *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// IsDynamic returns true, if the fees are EIP-1559 dynamic fees.
+func (f *Fees) IsDynamic() bool {
+	return f.GasFeeCap != nil
+}
+
+// SuggestFees determines the fees for a transaction according to the given
+// options. For dynamic fees, the tip cap is suggested by the backend and
+// the fee cap is the multiplied base fee of the latest block plus the tip.
+func SuggestFees(ctx context.Context, backend FeeBackend, opts FeeOptions) (*Fees, error) {
+	if opts.Strategy == "" {
+		opts.Strategy = GasStrategyAuto
+	}
+	if opts.BaseFeeMultiplier == 0 {
+		opts.BaseFeeMultiplier = DefaultBaseFeeMultiplier
+	}
+	if opts.BaseFeeMultiplier < 1 {
+		return nil, fmt.Errorf("base fee multiplier must be at least 1, got %v", opts.BaseFeeMultiplier)
+	}
+
+	var baseFee *big.Int
+	switch opts.Strategy {
+	case GasStrategyLegacy:
+	case GasStrategyAuto, GasStrategyDynamic:
+		header, err := backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		baseFee = header.BaseFee
+		if baseFee == nil && opts.Strategy == GasStrategyDynamic {
+			return nil, fmt.Errorf("gas strategy %q requires a base fee, but the latest block has none", GasStrategyDynamic)
+		}
+	default:
+		return nil, fmt.Errorf("invalid gas strategy %q", opts.Strategy)
+	}
+
+	// Fall back to legacy gas prices on chains without fee market
+	if baseFee == nil {
+		gasPrice, err := backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &Fees{GasPrice: gasPrice}, nil
+	}
+
+	gasTipCap, err := backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate fee cap = base fee * multiplier + tip cap
+	gasFeeCap, _ := new(big.Float).Mul(new(big.Float).SetInt(baseFee), big.NewFloat(opts.BaseFeeMultiplier)).Int(nil)
+	gasFeeCap.Add(gasFeeCap, gasTipCap)
+
+	return &Fees{
+		BaseFee:   baseFee,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+	}, nil
+}
+
+// apply sets the fees on the transaction signer and the call message, which
+// is used for the gas estimation.
+func (f *Fees) apply(auth *bind.TransactOpts, callMsg *ethereum.CallMsg) {
+	auth.GasPrice, auth.GasTipCap, auth.GasFeeCap = f.GasPrice, f.GasTipCap, f.GasFeeCap
+	callMsg.GasPrice, callMsg.GasTipCap, callMsg.GasFeeCap = f.GasPrice, f.GasTipCap, f.GasFeeCap
+}
+
+// DescribeFees returns a human-readable description of the fee mode and
+// values, that are set on the given transaction signer.
+func DescribeFees(auth *bind.TransactOpts) string {
+	if auth.GasFeeCap != nil {
+		return fmt.Sprintf("dynamic (EIP-1559), max fee per gas %v, max priority fee per gas %v", auth.GasFeeCap, auth.GasTipCap)
+	}
+
+	return fmt.Sprintf("legacy, gas price %v", auth.GasPrice)
+}
@@ -0,0 +1,132 @@
+// fees_test.go contains the unit tests for the determination of
+// transaction fees.
+package util
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyFeeBackend mocks a chain without fee market, whose block headers
+// contain no base fee.
+type legacyFeeBackend struct{}
+
+func (legacyFeeBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1)}, nil
+}
+
+func (legacyFeeBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return big.NewInt(7), nil
+}
+
+func (legacyFeeBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+// TestSuggestFees tests the fee suggestions for the different gas
+// strategies on chains with and without fee market.
+func TestSuggestFees(t *testing.T) {
+	privKeys, _, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private keys")
+
+	// The simulated backend uses London rules, so its blocks contain a base fee.
+	client, _, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client and transaction signer")
+
+	header, err := client.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err, "Error getting latest header")
+	require.NotNil(t, header.BaseFee, "Simulated backend should have a base fee")
+	tipCap, err := client.SuggestGasTipCap(context.Background())
+	require.NoError(t, err, "Error getting tip cap")
+
+	testcases := []struct {
+		name       string
+		expErr     bool
+		backend    FeeBackend
+		opts       FeeOptions
+		expDynamic bool
+		expFeeCap  *big.Int
+	}{
+		{
+			"passes - auto uses dynamic fees with base fee",
+			false,
+			client,
+			DefaultFeeOptions(),
+			true,
+			new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), tipCap),
+		},
+		{
+			"passes - dynamic with custom multiplier",
+			false,
+			client,
+			FeeOptions{Strategy: GasStrategyDynamic, BaseFeeMultiplier: 3},
+			true,
+			new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(3)), tipCap),
+		},
+		{
+			"passes - legacy with base fee",
+			false,
+			client,
+			FeeOptions{Strategy: GasStrategyLegacy},
+			false,
+			nil,
+		},
+		{
+			"passes - auto falls back to legacy without base fee",
+			false,
+			legacyFeeBackend{},
+			DefaultFeeOptions(),
+			false,
+			nil,
+		},
+		{
+			"fails - dynamic without base fee",
+			true,
+			legacyFeeBackend{},
+			FeeOptions{Strategy: GasStrategyDynamic},
+			false,
+			nil,
+		},
+		{
+			"fails - base fee multiplier below one",
+			true,
+			client,
+			FeeOptions{Strategy: GasStrategyAuto, BaseFeeMultiplier: 0.5},
+			false,
+			nil,
+		},
+		{
+			"fails - invalid gas strategy",
+			true,
+			client,
+			FeeOptions{Strategy: "free"},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			fees, err := SuggestFees(context.Background(), tc.backend, tc.opts)
+			if tc.expErr {
+				require.Error(t, err, "Invalid fee options should raise an error")
+				return
+			}
+
+			require.NoError(t, err, "Error suggesting fees")
+			require.Equal(t, tc.expDynamic, fees.IsDynamic(), "Wrong fee mode")
+			if tc.expDynamic {
+				require.Nil(t, fees.GasPrice, "Gas price should not be set for dynamic fees")
+				require.Equal(t, tc.expFeeCap, fees.GasFeeCap, "Wrong fee cap")
+				require.Equal(t, tipCap, fees.GasTipCap, "Wrong tip cap")
+			} else {
+				require.NotNil(t, fees.GasPrice, "Gas price should be set for legacy fees")
+				require.Nil(t, fees.GasTipCap, "Tip cap should not be set for legacy fees")
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// hdwallet.go contains the functions to derive signing keys deterministically
+// from a BIP-39 mnemonic using BIP-32 hierarchical deterministic derivation on
+// the BIP-44 Ethereum path, which is also used by Evmos.
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// DefaultMnemonicBits is the entropy of new mnemonics, which results in
+	// 24 words.
+	DefaultMnemonicBits = 256
+
+	// EnvMnemonic is the environment variable, which can hold the mnemonic
+	// to derive accounts from.
+	EnvMnemonic = "MALTCOIN_MNEMONIC"
+
+	// TestMnemonic is a well-known mnemonic, which is used to derive
+	// reproducible accounts in tests. It must never hold real funds.
+	TestMnemonic = "test test test test test test test test test test test junk"
+)
+
+// ErrInvalidMnemonic is returned, when a mnemonic has an unknown word or
+// a wrong checksum.
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// hardenedOffset is added to the index of hardened child keys.
+const hardenedOffset = 0x80000000
+
+// masterKeySalt is the HMAC key, which is used to derive the master key
+// from the seed as specified in BIP-32.
+var masterKeySalt = []byte("Bitcoin seed")
+
+// NewMnemonic generates a random BIP-39 mnemonic with the given entropy in
+// bits, which must be a multiple of 32 between 128 and 256.
+func NewMnemonic(bits int) (string, error) {
+	entropy, err := bip39.NewEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// SeedFromMnemonic validates the mnemonic and returns the BIP-39 seed for it
+// and the optional passphrase.
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMnemonic, err)
+	}
+
+	return seed, nil
+}
+
+// AccountPath returns the derivation path m/44'/60'/0'/0/index of the
+// account with the given index.
+func AccountPath(index uint32) accounts.DerivationPath {
+	path := make(accounts.DerivationPath, len(accounts.DefaultRootDerivationPath), len(accounts.DefaultRootDerivationPath)+1)
+	copy(path, accounts.DefaultRootDerivationPath)
+
+	return append(path, index)
+}
+
+// DeriveKey derives the private key at the given path from the seed as
+// specified in BIP-32.
+func DeriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	key, chainCode, err := splitKey(masterKeySalt, seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive master key: %w", err)
+	}
+
+	for _, index := range path {
+		// Hardened children are derived from the private key, the other
+		// children from the compressed public key.
+		var data []byte
+		if index >= hardenedOffset {
+			data = append([]byte{0}, crypto.FromECDSA(key)...)
+		} else {
+			data = crypto.CompressPubkey(&key.PublicKey)
+		}
+		data = append(data, byte(index>>24), byte(index>>16), byte(index>>8), byte(index))
+
+		tweak, childChainCode, err := splitKey(chainCode, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive child key %d: %w", index, err)
+		}
+		childKey := new(big.Int).Add(tweak.D, key.D)
+		childKey.Mod(childKey, crypto.S256().Params().N)
+		if childKey.Sign() == 0 {
+			return nil, fmt.Errorf("failed to derive child key %d: invalid key", index)
+		}
+
+		if key, err = crypto.ToECDSA(common.LeftPadBytes(childKey.Bytes(), 32)); err != nil {
+			return nil, err
+		}
+		chainCode = childChainCode
+	}
+
+	return key, nil
+}
+
+// splitKey computes HMAC-SHA512 of the data and splits it into the key and
+// the chain code.
+func splitKey(hmacKey, data []byte) (*ecdsa.PrivateKey, []byte, error) {
+	mac := hmac.New(sha512.New, hmacKey)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	// ToECDSA rejects keys, which are zero or not below the curve order
+	key, err := crypto.ToECDSA(sum[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, sum[32:], nil
+}
+
+// DerivePrivKeysAndAddresses derives n private keys and their addresses from
+// the mnemonic on the paths m/44'/60'/0'/0/0 to m/44'/60'/0'/0/(n-1). These
+// are the same accounts, which wallets and evmosd create from the mnemonic.
+func DerivePrivKeysAndAddresses(mnemonic, passphrase string, n uint64) ([]*ecdsa.PrivateKey, []common.Address, error) {
+	seed, err := SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DerivePrivKeysAndAddressesFromSeed(seed, n)
+}
+
+// DerivePrivKeysAndAddressesFromSeed derives n private keys and their
+// addresses from the BIP-39 seed.
+func DerivePrivKeysAndAddressesFromSeed(seed []byte, n uint64) ([]*ecdsa.PrivateKey, []common.Address, error) {
+	if n > hardenedOffset {
+		return nil, nil, fmt.Errorf("cannot derive more than %d accounts", uint64(hardenedOffset))
+	}
+
+	privKeys := make([]*ecdsa.PrivateKey, n)
+	addresses := make([]common.Address, n)
+	for i := uint64(0); i < n; i++ {
+		privKey, err := DeriveKey(seed, AccountPath(uint32(i)))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		privKeys[i] = privKey
+		addresses[i] = crypto.PubkeyToAddress(privKey.PublicKey)
+	}
+
+	return privKeys, addresses, nil
+}
@@ -0,0 +1,111 @@
+// hdwallet_test.go contains the unit tests for the derivation of keys from
+// a mnemonic.
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeriveKey tests the BIP-32 derivation with the test vector 1 of the
+// specification.
+func TestDeriveKey(t *testing.T) {
+	seed := common.FromHex("000102030405060708090a0b0c0d0e0f")
+
+	testcases := []struct {
+		path   string
+		expKey string
+	}{
+		{"m", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{"m/0'/1/2'", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.path, func(t *testing.T) {
+			var path accounts.DerivationPath
+			if tc.path != "m" {
+				var err error
+				path, err = accounts.ParseDerivationPath(tc.path)
+				require.NoError(t, err, "Error parsing derivation path")
+			}
+
+			privKey, err := DeriveKey(seed, path)
+			require.NoError(t, err, "Error deriving key")
+			require.Equal(t, tc.expKey, common.Bytes2Hex(crypto.FromECDSA(privKey)), "Wrong private key")
+		})
+	}
+}
+
+// TestDerivePrivKeysAndAddresses tests, that the accounts derived from a
+// mnemonic match the ones created by common wallets.
+func TestDerivePrivKeysAndAddresses(t *testing.T) {
+	testcases := []struct {
+		name         string
+		mnemonic     string
+		passphrase   string
+		expErr       bool
+		expKeys      []string
+		expAddresses []string
+	}{
+		{
+			"passes - test mnemonic",
+			TestMnemonic,
+			"",
+			false,
+			[]string{
+				"0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+				"0x59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d",
+			},
+			[]string{
+				"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+				"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+			},
+		},
+		{"fails - wrong checksum", strings.Replace(TestMnemonic, "junk", "test", 1), "", true, nil, nil},
+		{"fails - unknown word", strings.Replace(TestMnemonic, "junk", "maltcoin", 1), "", true, nil, nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			privKeys, addresses, err := DerivePrivKeysAndAddresses(tc.mnemonic, tc.passphrase, uint64(len(tc.expKeys)))
+			if tc.expErr {
+				require.ErrorIs(t, err, ErrInvalidMnemonic, "Invalid mnemonic should raise an error")
+				return
+			}
+
+			require.NoError(t, err, "Error deriving accounts")
+			for i := range tc.expKeys {
+				require.Equal(t, tc.expKeys[i], hexutil.Encode(crypto.FromECDSA(privKeys[i])), "Wrong private key %d", i)
+				require.Equal(t, common.HexToAddress(tc.expAddresses[i]), addresses[i], "Wrong address %d", i)
+			}
+		})
+	}
+
+	// The passphrase changes the seed and therefore all accounts
+	_, withPassphrase, err := DerivePrivKeysAndAddresses(TestMnemonic, "maltcoin", 1)
+	require.NoError(t, err, "Error deriving accounts")
+	_, withoutPassphrase, err := DerivePrivKeysAndAddresses(TestMnemonic, "", 1)
+	require.NoError(t, err, "Error deriving accounts")
+	require.NotEqual(t, withoutPassphrase[0], withPassphrase[0], "Passphrase should change the accounts")
+}
+
+// TestNewMnemonic tests, that generated mnemonics are valid.
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic(DefaultMnemonicBits)
+	require.NoError(t, err, "Error generating mnemonic")
+	require.Len(t, strings.Fields(mnemonic), 24, "Wrong number of words")
+
+	_, err = SeedFromMnemonic(mnemonic, "")
+	require.NoError(t, err, "Generated mnemonic should be valid")
+
+	_, err = NewMnemonic(100)
+	require.Error(t, err, "Invalid entropy size should raise an error")
+}
@@ -0,0 +1,250 @@
+// indexer.go contains the indexer, which follows the chain from the
+// deployment of a token contract and stores its Transfer and Approval
+// events in an event store.
+package util
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// IndexerBackend defines the methods, which the indexer needs to filter and
+// subscribe to the events of the token contract.
+type IndexerBackend interface {
+	bind.ContractFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// IndexerOptions configure which blocks are indexed and how.
+type IndexerOptions struct {
+	// StartBlock is the first block to index, e.g. the deployment block of
+	// the contract. It is only used, if the store has no progress yet.
+	StartBlock uint64
+	// BatchSize is the maximum number of blocks, which are filtered with
+	// one query.
+	BatchSize uint64
+	// Confirmations is the number of blocks, which have to follow a block
+	// before it is indexed.
+	Confirmations uint64
+	// Live subscribes to new events after catching up with the chain.
+	Live bool
+	// PollInterval is the time between two catch-ups in live mode, which
+	// advance the progress also for blocks without events.
+	PollInterval time.Duration
+	// OnProgress is called after each indexed batch with the last indexed
+	// block and the block the indexer is catching up to.
+	OnProgress func(block, target uint64)
+}
+
+// DefaultIndexerOptions returns the indexer options, which are used if not
+// configured otherwise.
+func DefaultIndexerOptions() IndexerOptions {
+	return IndexerOptions{
+		BatchSize:    2000,
+		PollInterval: 5 * time.Second,
+	}
+}
+
+// Indexer stores the Transfer and Approval events of a token contract in an
+// event store. The progress is stored together with the events, so that a
+// restarted indexer resumes after the last indexed block.
+type Indexer struct {
+	backend  IndexerBackend
+	filterer *maltcoin.MaltcoinFilterer
+	store    *EventStore
+	opts     IndexerOptions
+}
+
+// NewIndexer creates an indexer for the contract of the given event store.
+func NewIndexer(backend IndexerBackend, store *EventStore, opts IndexerOptions) (*Indexer, error) {
+	filterer, err := maltcoin.NewMaltcoinFilterer(store.Contract(), backend)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := DefaultIndexerOptions()
+	if opts.BatchSize == 0 {
+		opts.BatchSize = defaults.BatchSize
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaults.PollInterval
+	}
+
+	return &Indexer{backend: backend, filterer: filterer, store: store, opts: opts}, nil
+}
+
+// Sync indexes all blocks from the stored progress or the start block up to
+// the latest block, which has the configured number of confirmations. The
+// blocks are indexed in batches and the progress is stored after each batch.
+// The last indexed block is returned.
+func (ix *Indexer) Sync(ctx context.Context) (uint64, error) {
+	next := ix.opts.StartBlock
+	progress, ok, err := ix.store.Progress()
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		next = progress + 1
+	}
+
+	header, err := ix.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return progress, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	head := header.Number.Uint64()
+	if head < ix.opts.Confirmations || head-ix.opts.Confirmations < next {
+		return progress, nil
+	}
+	target := head - ix.opts.Confirmations
+
+	for from := next; from <= target; from += ix.opts.BatchSize {
+		to := from + ix.opts.BatchSize - 1
+		if to > target {
+			to = target
+		}
+
+		events, err := ix.filterEvents(ctx, from, to)
+		if err != nil {
+			return progress, fmt.Errorf("failed to filter events in blocks %d to %d: %w", from, to, err)
+		}
+		if err := ix.store.Store(from, to, events); err != nil {
+			return progress, fmt.Errorf("failed to store events: %w", err)
+		}
+		progress = to
+
+		if ix.opts.OnProgress != nil {
+			ix.opts.OnProgress(to, target)
+		}
+	}
+
+	return progress, nil
+}
+
+// Run catches up with the chain. In live mode, it subscribes to new events
+// afterwards and keeps indexing until the context is canceled. Events of
+// the subscription are stored immediately, while the progress is advanced
+// by periodic catch-ups. Events of blocks, which are removed by a
+// reorganization, are deleted, when the subscription reports them as
+// removed or at the latest, when the catch-up indexes their blocks again.
+func (ix *Indexer) Run(ctx context.Context) error {
+	if _, err := ix.Sync(ctx); err != nil {
+		return err
+	}
+	if !ix.opts.Live {
+		return nil
+	}
+
+	transfers := make(chan *maltcoin.MaltcoinTransfer)
+	transferSub, err := ix.filterer.WatchTransfer(&bind.WatchOpts{Context: ctx}, transfers, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to transfers: %w", err)
+	}
+	defer transferSub.Unsubscribe()
+
+	approvals := make(chan *maltcoin.MaltcoinApproval)
+	approvalSub, err := ix.filterer.WatchApproval(&bind.WatchOpts{Context: ctx}, approvals, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to approvals: %w", err)
+	}
+	defer approvalSub.Unsubscribe()
+
+	ticker := time.NewTicker(ix.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-transferSub.Err():
+			return fmt.Errorf("transfer subscription failed: %w", err)
+		case err = <-approvalSub.Err():
+			return fmt.Errorf("approval subscription failed: %w", err)
+		case transfer := <-transfers:
+			err = ix.apply(transferEvent(transfer))
+		case approval := <-approvals:
+			err = ix.apply(approvalEvent(approval))
+		case <-ticker.C:
+			_, err = ix.Sync(ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// apply stores an event of the subscription or deletes it, if its log was
+// removed by a reorganization.
+func (ix *Indexer) apply(event IndexedEvent, removed bool) error {
+	if removed {
+		return ix.store.Delete(event.BlockNumber, event.LogIndex)
+	}
+
+	return ix.store.Put(event)
+}
+
+// filterEvents returns the Transfer and Approval events in the given range
+// of blocks.
+func (ix *Indexer) filterEvents(ctx context.Context, from, to uint64) ([]IndexedEvent, error) {
+	opts := &bind.FilterOpts{Start: from, End: &to, Context: ctx}
+
+	var events []IndexedEvent
+	transfers, err := ix.filterer.FilterTransfer(opts, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer transfers.Close()
+	for transfers.Next() {
+		event, _ := transferEvent(transfers.Event)
+		events = append(events, event)
+	}
+	if err := transfers.Error(); err != nil {
+		return nil, err
+	}
+
+	approvals, err := ix.filterer.FilterApproval(opts, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer approvals.Close()
+	for approvals.Next() {
+		event, _ := approvalEvent(approvals.Event)
+		events = append(events, event)
+	}
+
+	return events, approvals.Error()
+}
+
+// transferEvent converts a Transfer event of the binding and returns, if
+// its log was removed.
+func transferEvent(transfer *maltcoin.MaltcoinTransfer) (IndexedEvent, bool) {
+	return newIndexedEvent(EventTransfer, transfer.Raw, transfer.From, transfer.To, transfer.Value), transfer.Raw.Removed
+}
+
+// approvalEvent converts an Approval event of the binding and returns, if
+// its log was removed.
+func approvalEvent(approval *maltcoin.MaltcoinApproval) (IndexedEvent, bool) {
+	return newIndexedEvent(EventApproval, approval.Raw, approval.Owner, approval.Spender, approval.Value), approval.Raw.Removed
+}
+
+// newIndexedEvent creates an indexed event from the given log and values.
+func newIndexedEvent(eventType string, log types.Log, from, to common.Address, value *big.Int) IndexedEvent {
+	return IndexedEvent{
+		Type:        eventType,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		From:        from,
+		To:          to,
+		Value:       value,
+	}
+}
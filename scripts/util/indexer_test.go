@@ -0,0 +1,118 @@
+// indexer_test.go contains the unit tests for the indexer of token events.
+package util
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIndexerSync tests indexing the events of a token contract in batches
+// and resuming from the stored progress.
+func TestIndexerSync(t *testing.T) {
+	privKeys, addresses, err := DerivePrivKeysAndAddresses(TestMnemonic, "", 3)
+	require.NoError(t, err, "Error deriving accounts")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client")
+	contractAddress, deployTx, contract, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+	deployReceipt, err := client.TransactionReceipt(context.Background(), deployTx.Hash())
+	require.NoError(t, err, "Error getting deployment receipt")
+
+	transferTx, err := TransferTokens(auth, client, contractAddress, addresses[1], big.NewInt(100), DefaultFeeOptions())
+	require.NoError(t, err, "Error transferring tokens")
+	auth.Nonce = nil
+	approveTx, err := contract.Approve(auth, addresses[2], big.NewInt(50))
+	require.NoError(t, err, "Error approving spender")
+	for i := 0; i < 4; i++ {
+		client.Commit()
+	}
+
+	store, err := OpenEventStore(t.TempDir(), contractAddress)
+	require.NoError(t, err, "Error opening event store")
+	defer store.Close()
+
+	var batches int
+	indexer, err := NewIndexer(client, store, IndexerOptions{
+		StartBlock:    deployReceipt.BlockNumber.Uint64(),
+		BatchSize:     2,
+		Confirmations: 1,
+		OnProgress:    func(_, _ uint64) { batches++ },
+	})
+	require.NoError(t, err, "Error creating indexer")
+
+	head := client.Blockchain().CurrentBlock().NumberU64()
+	progress, err := indexer.Sync(context.Background())
+	require.NoError(t, err, "Error syncing events")
+	require.Equal(t, head-1, progress, "Indexer should stop before the unconfirmed block")
+	require.Equal(t, 3, batches, "Wrong number of batches")
+
+	events, err := store.EventsInBlocks(0, progress)
+	require.NoError(t, err, "Error querying events")
+	require.Len(t, events, 3, "Expected mint, transfer and approval")
+	require.Equal(t, common.Address{}, events[0].From, "First event should be the mint")
+	require.Equal(t, transferTx.Hash(), events[1].TxHash, "Wrong transfer transaction")
+	require.Equal(t, big.NewInt(100), events[1].Value, "Wrong transfer value")
+	require.Equal(t, EventApproval, events[2].Type, "Wrong event type")
+	require.Equal(t, approveTx.Hash(), events[2].TxHash, "Wrong approval transaction")
+	require.Equal(t, addresses[2], events[2].To, "Spender should be stored as recipient")
+
+	// A new indexer resumes after the stored progress
+	_, err = TransferTokens(auth, client, contractAddress, addresses[2], big.NewInt(7), DefaultFeeOptions())
+	require.NoError(t, err, "Error transferring tokens")
+	client.Commit()
+
+	batches = 0
+	indexer, err = NewIndexer(client, store, IndexerOptions{OnProgress: func(_, _ uint64) { batches++ }})
+	require.NoError(t, err, "Error creating indexer")
+	_, err = indexer.Sync(context.Background())
+	require.NoError(t, err, "Error syncing events")
+	require.Equal(t, 1, batches, "Indexer should only index the new blocks")
+
+	events, err = store.EventsByAddress(addresses[2])
+	require.NoError(t, err, "Error querying events")
+	require.Len(t, events, 2, "Expected approval and transfer")
+	require.Equal(t, big.NewInt(7), events[1].Value, "Wrong transfer value")
+}
+
+// TestIndexerLive tests storing new events of the live subscription.
+func TestIndexerLive(t *testing.T) {
+	privKeys, addresses, err := DerivePrivKeysAndAddresses(TestMnemonic, "", 2)
+	require.NoError(t, err, "Error deriving accounts")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client")
+	contractAddress, _, _, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	store, err := OpenEventStore(t.TempDir(), contractAddress)
+	require.NoError(t, err, "Error opening event store")
+	defer store.Close()
+	indexer, err := NewIndexer(client, store, IndexerOptions{Live: true, PollInterval: time.Hour})
+	require.NoError(t, err, "Error creating indexer")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- indexer.Run(ctx) }()
+
+	// The mint is indexed by the initial catch-up
+	require.Eventually(t, func() bool {
+		progress, ok, err := store.Progress()
+		return err == nil && ok && progress == client.Blockchain().CurrentBlock().NumberU64()
+	}, 5*time.Second, 10*time.Millisecond, "Indexer should catch up")
+
+	tx, err := TransferTokens(auth, client, contractAddress, addresses[1], big.NewInt(42), DefaultFeeOptions())
+	require.NoError(t, err, "Error transferring tokens")
+	require.Eventually(t, func() bool {
+		events, err := store.EventsByTx(tx.Hash())
+		return err == nil && len(events) == 1
+	}, 5*time.Second, 10*time.Millisecond, "Transfer should be indexed from the subscription")
+
+	cancel()
+	require.NoError(t, <-done, "Indexer should stop without error")
+}
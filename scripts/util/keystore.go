@@ -0,0 +1,91 @@
+// keystore.go contains the functions to manage signing keys, which are
+// stored in encrypted Web3 Secret Storage (keystore v3) files, and to create
+// transaction signers from them.
+package util
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultKeystoreDir is the directory of the keystore, if no other
+	// directory is configured.
+	DefaultKeystoreDir = "keystore"
+
+	// EnvKeystore is the environment variable, which defines the keystore
+	// directory.
+	EnvKeystore = "MALTCOIN_KEYSTORE"
+)
+
+// ErrAccountNotFound is returned, when there is no key for an address
+// in the keystore.
+var ErrAccountNotFound = errors.New("account not found in keystore")
+
+// OpenKeystore opens the keystore in the given directory, which is created
+// on demand. New keys are encrypted with the standard scrypt parameters.
+func OpenKeystore(dir string) *keystore.KeyStore {
+	return keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP)
+}
+
+// FindAccount returns the keystore account with the given address.
+func FindAccount(ks *keystore.KeyStore, address common.Address) (accounts.Account, error) {
+	account, err := ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("%w: %s", ErrAccountNotFound, address)
+	}
+
+	return account, nil
+}
+
+// ImportKey encrypts the given private key with the passphrase and stores
+// it in the keystore.
+func ImportKey(ks *keystore.KeyStore, privKey *ecdsa.PrivateKey, passphrase string) (accounts.Account, error) {
+	return ks.ImportECDSA(privKey, passphrase)
+}
+
+// ExportKey decrypts the key of the account with the given address using
+// the passphrase and returns the private key.
+func ExportKey(ks *keystore.KeyStore, address common.Address, passphrase string) (*ecdsa.PrivateKey, error) {
+	account, err := FindAccount(ks, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return LoadKeyFile(account.URL.Path, passphrase)
+}
+
+// LoadKeyFile decrypts the keystore v3 file at the given path using the
+// passphrase and returns the private key.
+func LoadKeyFile(path, passphrase string) (*ecdsa.PrivateKey, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt key file %s: %w", path, err)
+	}
+
+	return key.PrivateKey, nil
+}
+
+// NewKeystoreTransactionSigner decrypts the key of the account with the
+// given address and uses it together with the chain ID of the backend to
+// create a transaction signer.
+func NewKeystoreTransactionSigner(backend Backend, ks *keystore.KeyStore, address common.Address, passphrase string) (*bind.TransactOpts, error) {
+	privKey, err := ExportKey(ks, address, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTransactionSigner(backend, privKey)
+}
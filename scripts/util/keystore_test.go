@@ -0,0 +1,83 @@
+// keystore_test.go contains the unit tests for the keystore handling.
+package util
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKeystore tests importing keys into a keystore, decrypting them and
+// using them to sign transactions.
+func TestKeystore(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+
+	// Use light scrypt parameters to keep the tests fast
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ImportKey(ks, privKeys[0], "secret")
+	require.NoError(t, err, "Error importing key")
+	require.Equal(t, addresses[0], account.Address, "Wrong account address")
+
+	_, err = ImportKey(ks, privKeys[0], "secret")
+	require.ErrorIs(t, err, keystore.ErrAccountAlreadyExists, "Importing a key twice should fail")
+
+	testcases := []struct {
+		name       string
+		expErr     bool
+		address    int
+		passphrase string
+	}{
+		{"passes - correct passphrase", false, 0, "secret"},
+		{"fails - wrong passphrase", true, 0, "wrong"},
+		{"fails - unknown account", true, 1, "secret"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			privKey, err := ExportKey(ks, addresses[tc.address], tc.passphrase)
+			if tc.expErr {
+				require.Error(t, err, "Exporting the key should fail")
+				return
+			}
+
+			require.NoError(t, err, "Error exporting key")
+			require.Equal(t, crypto.FromECDSA(privKeys[0]), crypto.FromECDSA(privKey), "Wrong private key")
+		})
+	}
+
+	// The key can be loaded from its file directly
+	found, err := FindAccount(ks, addresses[0])
+	require.NoError(t, err, "Error finding account")
+	_, err = LoadKeyFile(found.URL.Path, "secret")
+	require.NoError(t, err, "Error loading key file")
+	_, err = LoadKeyFile(filepath.Join(t.TempDir(), "missing.json"), "secret")
+	require.Error(t, err, "Loading a missing key file should fail")
+}
+
+// TestNewKeystoreTransactionSigner tests, that transactions signed with
+// a key from the keystore are accepted by the backend.
+func TestNewKeystoreTransactionSigner(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private key")
+
+	client, _, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client")
+
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	_, err = ImportKey(ks, privKeys[0], "secret")
+	require.NoError(t, err, "Error importing key")
+
+	_, err = NewKeystoreTransactionSigner(client, ks, addresses[0], "wrong")
+	require.Error(t, err, "Wrong passphrase should raise an error")
+
+	auth, err := NewKeystoreTransactionSigner(client, ks, addresses[0], "secret")
+	require.NoError(t, err, "Error creating transaction signer")
+	require.Equal(t, addresses[0], auth.From, "Wrong signer address")
+
+	_, _, _, err = DeployContract(auth, client, DefaultTokenSettings(), DefaultFeeOptions())
+	require.NoError(t, err, "Error deploying contract with keystore signer")
+}
@@ -0,0 +1,183 @@
+// logs.go contains the decoding of event logs, which are contained in
+// transaction receipts, using the event definitions of contract ABIs.
+package util
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// LogDecoder decodes event logs using the events of the registered ABIs.
+type LogDecoder struct {
+	// events contains the candidate events for every event ID. Events
+	// with the same signature can differ in the indexed arguments, e.g.
+	// the Transfer events of ERC-20 and ERC-721.
+	events map[common.Hash][]abi.Event
+}
+
+// LogField is a decoded argument of an event.
+type LogField struct {
+	Name    string
+	Type    abi.Type
+	Indexed bool
+	Value   interface{}
+}
+
+// DecodedLog is an event log together with its decoded arguments. Event is
+// nil, if the log does not match any registered event.
+type DecodedLog struct {
+	Log    *types.Log
+	Event  *abi.Event
+	Fields []LogField
+}
+
+// NewLogDecoder creates a log decoder for the events of the given ABIs.
+func NewLogDecoder(abis ...*abi.ABI) *LogDecoder {
+	d := &LogDecoder{events: make(map[common.Hash][]abi.Event)}
+	for _, contractABI := range abis {
+		d.Register(contractABI)
+	}
+
+	return d
+}
+
+// NewMaltcoinLogDecoder creates a log decoder for the events of the Maltcoin
+// ABI and the given additional ABIs.
+func NewMaltcoinLogDecoder(abis ...*abi.ABI) (*LogDecoder, error) {
+	maltcoinABI, err := GetMaltcoinABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLogDecoder(append([]*abi.ABI{maltcoinABI}, abis...)...), nil
+}
+
+// Register adds the events of the given ABI to the decoder. Anonymous
+// events are skipped, because their logs do not contain an event ID.
+func (d *LogDecoder) Register(contractABI *abi.ABI) {
+	for _, event := range contractABI.Events {
+		if event.Anonymous {
+			continue
+		}
+		d.events[event.ID] = append(d.events[event.ID], event)
+	}
+}
+
+// Decode decodes the arguments of the given log. If no registered event
+// matches the log, it is returned without event and fields.
+func (d *LogDecoder) Decode(log *types.Log) *DecodedLog {
+	decoded := &DecodedLog{Log: log}
+	if len(log.Topics) == 0 {
+		return decoded
+	}
+
+	for _, event := range d.events[log.Topics[0]] {
+		event := event
+		fields, err := decodeEvent(&event, log)
+		if err != nil {
+			continue
+		}
+		decoded.Event = &event
+		decoded.Fields = fields
+		break
+	}
+
+	return decoded
+}
+
+// DecodeAll decodes all given logs.
+func (d *LogDecoder) DecodeAll(logs []*types.Log) []*DecodedLog {
+	decoded := make([]*DecodedLog, len(logs))
+	for i, log := range logs {
+		decoded[i] = d.Decode(log)
+	}
+
+	return decoded
+}
+
+// decodeEvent decodes the indexed arguments of the event from the topics
+// and the other arguments from the data of the log.
+func decodeEvent(event *abi.Event, log *types.Log) ([]LogField, error) {
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if len(indexed) != len(log.Topics)-1 {
+		return nil, fmt.Errorf("expected %d topics, got %d", len(indexed)+1, len(log.Topics))
+	}
+
+	values, err := event.Inputs.NonIndexed().UnpackValues(log.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]LogField, 0, len(event.Inputs))
+	topic, value := 1, 0
+	for _, input := range event.Inputs {
+		field := LogField{Name: input.Name, Type: input.Type, Indexed: input.Indexed}
+		if input.Indexed {
+			// Dynamic types are only stored as hash in the topic
+			out := make(map[string]interface{}, 1)
+			if err := abi.ParseTopicsIntoMap(out, abi.Arguments{input}, log.Topics[topic:topic+1]); err != nil {
+				return nil, err
+			}
+			field.Value = out[input.Name]
+			topic++
+		} else {
+			field.Value = values[value]
+			value++
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// IsTokenAmount returns true, if the field is the token amount of an ERC-20
+// Transfer or Approval event, which can be formatted with the decimals of
+// the token.
+func (l *DecodedLog) IsTokenAmount(field LogField) bool {
+	if l.Event == nil || field.Name != "value" || field.Type.T != abi.UintTy {
+		return false
+	}
+
+	switch l.Event.Sig {
+	case "Transfer(address,address,uint256)", "Approval(address,address,uint256)":
+		// ERC-721 logs have the same signature, but an indexed token ID
+		return !field.Indexed
+	}
+
+	return false
+}
+
+// FormatUnits formats an amount in the smallest unit of a token as decimal
+// number with the given number of decimals, e.g. 1500 with 3 decimals as
+// "1.5".
+func FormatUnits(amount *big.Int, decimals uint8) string {
+	if decimals == 0 {
+		return amount.String()
+	}
+
+	digits := new(big.Int).Abs(amount).String()
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	integer, fraction := digits[:len(digits)-int(decimals)], strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+	if fraction == "" {
+		return sign + integer
+	}
+
+	return sign + integer + "." + fraction
+}
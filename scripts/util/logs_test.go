@@ -0,0 +1,104 @@
+// logs_test.go contains the unit tests for the decoding of event logs.
+package util
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// erc721ABI contains the Transfer event of ERC-721 tokens, which has the
+// same signature as the ERC-20 Transfer event, but an indexed token ID.
+const erc721ABI = `[{"anonymous":false,"inputs":[
+	{"indexed":true,"name":"from","type":"address"},
+	{"indexed":true,"name":"to","type":"address"},
+	{"indexed":true,"name":"tokenId","type":"uint256"}],
+	"name":"Transfer","type":"event"}]`
+
+// TestLogDecoder tests decoding the logs of a token transfer.
+func TestLogDecoder(t *testing.T) {
+	privKeys, addresses, err := DerivePrivKeysAndAddresses(TestMnemonic, "", 2)
+	require.NoError(t, err, "Error deriving accounts")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client")
+	contractAddress, _, _, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	amount := big.NewInt(1500)
+	tx, err := TransferTokens(auth, client, contractAddress, addresses[1], amount, DefaultFeeOptions())
+	require.NoError(t, err, "Error transferring tokens")
+	receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(t, err, "Error getting receipt")
+	require.Len(t, receipt.Logs, 1, "Transfer should emit one log")
+
+	decoder, err := NewMaltcoinLogDecoder()
+	require.NoError(t, err, "Error creating log decoder")
+
+	decoded := decoder.Decode(receipt.Logs[0])
+	require.NotNil(t, decoded.Event, "Transfer event should be decoded")
+	require.Equal(t, "Transfer", decoded.Event.Name, "Wrong event")
+	require.Len(t, decoded.Fields, 3, "Wrong number of fields")
+
+	expFields := []struct {
+		name    string
+		indexed bool
+		value   interface{}
+		amount  bool
+	}{
+		{"from", true, addresses[0], false},
+		{"to", true, addresses[1], false},
+		{"value", false, amount, true},
+	}
+	for i, exp := range expFields {
+		field := decoded.Fields[i]
+		require.Equal(t, exp.name, field.Name, "Wrong field name")
+		require.Equal(t, exp.indexed, field.Indexed, "Wrong indexed flag of %s", exp.name)
+		require.Equal(t, exp.value, field.Value, "Wrong value of %s", exp.name)
+		require.Equal(t, exp.amount, decoded.IsTokenAmount(field), "Wrong token amount flag of %s", exp.name)
+	}
+
+	// An ERC-721 transfer log is decoded with the registered ERC-721 ABI
+	nftABI, err := abi.JSON(strings.NewReader(erc721ABI))
+	require.NoError(t, err, "Error parsing ABI")
+	decoder.Register(&nftABI)
+	nftLog := &types.Log{Topics: append(append([]common.Hash{}, receipt.Logs[0].Topics...), common.BigToHash(big.NewInt(7)))}
+	decoded = decoder.Decode(nftLog)
+	require.NotNil(t, decoded.Event, "ERC-721 transfer should be decoded")
+	require.Equal(t, big.NewInt(7), decoded.Fields[2].Value, "Wrong token ID")
+	require.False(t, decoded.IsTokenAmount(decoded.Fields[2]), "Token ID is no token amount")
+
+	// Logs of unknown events are returned without fields
+	unknown := decoder.Decode(&types.Log{Topics: []common.Hash{common.HexToHash("0x01")}, Data: []byte{1}})
+	require.Nil(t, unknown.Event, "Unknown event should not be decoded")
+	require.Empty(t, unknown.Fields, "Unknown event should not have fields")
+	require.Nil(t, decoder.Decode(&types.Log{}).Event, "Log without topics should not be decoded")
+}
+
+// TestFormatUnits tests formatting amounts with the decimals of a token.
+func TestFormatUnits(t *testing.T) {
+	testcases := []struct {
+		amount   *big.Int
+		decimals uint8
+		expected string
+	}{
+		{big.NewInt(1500), 3, "1.5"},
+		{big.NewInt(1500), 0, "1500"},
+		{big.NewInt(15), 3, "0.015"},
+		{big.NewInt(0), 18, "0"},
+		{big.NewInt(-2500), 3, "-2.5"},
+		{new(big.Int).Mul(big.NewInt(10000), Ten18), 18, "10000"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			require.Equal(t, tc.expected, FormatUnits(tc.amount, tc.decimals), "Wrong formatted amount")
+		})
+	}
+}
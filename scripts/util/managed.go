@@ -0,0 +1,335 @@
+// managed.go contains the functions to deploy and administer the managed
+// variant of the Maltcoin token contract, whose supply can be minted and
+// burned and whose transfers can be paused by accounts with the respective
+// roles.
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrUnknownRole is returned, when a role is neither known by name nor given
+// as 32 bytes hex.
+var ErrUnknownRole = errors.New("unknown role")
+
+// Role is a role of the AccessControl contract, which is identified by the
+// hash of its name.
+type Role struct {
+	Name string
+	Hash common.Hash
+}
+
+// Roles of the managed token contract
+var (
+	// AdminRole is the DEFAULT_ADMIN_ROLE, which grants and revokes all roles.
+	AdminRole = Role{Name: "admin", Hash: common.Hash{}}
+	// MinterRole is allowed to mint tokens.
+	MinterRole = Role{Name: "minter", Hash: crypto.Keccak256Hash([]byte("MINTER_ROLE"))}
+	// PauserRole is allowed to pause and unpause transfers.
+	PauserRole = Role{Name: "pauser", Hash: crypto.Keccak256Hash([]byte("PAUSER_ROLE"))}
+)
+
+// ManagedRoles returns the roles of the managed token contract.
+func ManagedRoles() []Role {
+	return []Role{AdminRole, MinterRole, PauserRole}
+}
+
+// ParseRole returns the role with the given name, e.g. "minter", or the
+// role given as 32 bytes hex, e.g. the hash of a custom role.
+func ParseRole(value string) (Role, error) {
+	for _, role := range ManagedRoles() {
+		if strings.EqualFold(value, role.Name) {
+			return role, nil
+		}
+	}
+
+	bz, err := decodeHex(value)
+	if err != nil || len(bz) != common.HashLength || !strings.HasPrefix(value, "0x") {
+		return Role{}, fmt.Errorf("%w %q: expected admin, minter, pauser or 32 bytes as hex", ErrUnknownRole, value)
+	}
+
+	return RoleByHash(common.BytesToHash(bz)), nil
+}
+
+// RoleByHash returns the role with the given hash. Unknown roles are named
+// by their hash.
+func RoleByHash(hash common.Hash) Role {
+	for _, role := range ManagedRoles() {
+		if role.Hash == hash {
+			return role
+		}
+	}
+
+	return Role{Name: hash.Hex(), Hash: hash}
+}
+
+// GetManagedABI returns the parsed ABI of the managed token contract.
+func GetManagedABI() (*abi.ABI, error) {
+	return maltcoin.ManagedMaltcoinMetaData.GetAbi()
+}
+
+// GetManagedDeployData returns the bytecode of the managed token contract
+// followed by the packed constructor arguments.
+func GetManagedDeployData(settings TokenSettings, admin common.Address) ([]byte, error) {
+	managedABI, err := GetManagedABI()
+	if err != nil {
+		return nil, err
+	}
+	constructorArgs, err := managedABI.Pack("", settings.Name, settings.Symbol, settings.InitialSupply, settings.InitialHolder, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(common.FromHex(maltcoin.ManagedMaltcoinMetaData.Bin), constructorArgs...), nil
+}
+
+// DeployManagedContract fills the transaction signer fields for the
+// deployment of the managed token contract with the given settings and
+// deploys it. All roles are granted to the given admin or, if it is the zero
+// address, to the deployer. The transaction is committed, if the given
+// backend is a simulated backend.
+func DeployManagedContract(auth *bind.TransactOpts, backend Backend, settings TokenSettings, admin common.Address, feeOpts FeeOptions) (common.Address, *types.Transaction, *maltcoin.ManagedMaltcoin, error) {
+	if err := settings.Validate(); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	deployData, err := GetManagedDeployData(settings, admin)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   nil,
+		Data: deployData,
+	}
+	auth, err = FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	contractAddress, tx, contract, err := maltcoin.DeployManagedMaltcoin(auth, backend, settings.Name, settings.Symbol, settings.InitialSupply, settings.InitialHolder, admin)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	// Commit transaction on simulated backend
+	Commit(backend)
+
+	return contractAddress, tx, contract, nil
+}
+
+// EstimateManagedDeployment fills the transaction signer fields for the
+// deployment of the managed token contract like DeployManagedContract, but
+// returns the estimate instead of sending the transaction.
+func EstimateManagedDeployment(auth *bind.TransactOpts, backend DryRunBackend, settings TokenSettings, admin common.Address, feeOpts FeeOptions) (*TransactionEstimate, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	deployData, err := GetManagedDeployData(settings, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	return estimateDeployment(auth, backend, deployData, feeOpts)
+}
+
+// GetManagedContract returns an instance of the managed token contract
+// binding for the given address. It fails, if there is no contract code
+// stored at the address.
+func GetManagedContract(backend bind.ContractBackend, address common.Address) (*maltcoin.ManagedMaltcoin, error) {
+	code, err := backend.CodeAt(context.Background(), address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrNoContractCode, address)
+	}
+
+	return maltcoin.NewManagedMaltcoin(address, backend)
+}
+
+// MintTokens mints the amount of tokens to the recipient. The signer needs
+// the minter role.
+func MintTokens(auth *bind.TransactOpts, backend Backend, contractAddress, recipient common.Address, amount *big.Int, feeOpts FeeOptions) (*types.Transaction, error) {
+	return transactManaged(auth, backend, contractAddress, feeOpts, "mint", recipient, amount)
+}
+
+// BurnTokens burns the amount of tokens of the signer.
+func BurnTokens(auth *bind.TransactOpts, backend Backend, contractAddress common.Address, amount *big.Int, feeOpts FeeOptions) (*types.Transaction, error) {
+	return transactManaged(auth, backend, contractAddress, feeOpts, "burn", amount)
+}
+
+// BurnTokensFrom burns the amount of tokens of the owner, who approved the
+// signer to spend them.
+func BurnTokensFrom(auth *bind.TransactOpts, backend Backend, contractAddress, owner common.Address, amount *big.Int, feeOpts FeeOptions) (*types.Transaction, error) {
+	return transactManaged(auth, backend, contractAddress, feeOpts, "burnFrom", owner, amount)
+}
+
+// PauseToken stops all transfers, mints and burns of the token. The signer
+// needs the pauser role.
+func PauseToken(auth *bind.TransactOpts, backend Backend, contractAddress common.Address, feeOpts FeeOptions) (*types.Transaction, error) {
+	return transactManaged(auth, backend, contractAddress, feeOpts, "pause")
+}
+
+// UnpauseToken resumes the transfers, mints and burns of the token. The
+// signer needs the pauser role.
+func UnpauseToken(auth *bind.TransactOpts, backend Backend, contractAddress common.Address, feeOpts FeeOptions) (*types.Transaction, error) {
+	return transactManaged(auth, backend, contractAddress, feeOpts, "unpause")
+}
+
+// GrantRole grants the role to the account. The signer needs the admin role
+// of the role.
+func GrantRole(auth *bind.TransactOpts, backend Backend, contractAddress common.Address, role Role, account common.Address, feeOpts FeeOptions) (*types.Transaction, error) {
+	return transactManaged(auth, backend, contractAddress, feeOpts, "grantRole", role.Hash, account)
+}
+
+// RevokeRole revokes the role from the account. The signer needs the admin
+// role of the role.
+func RevokeRole(auth *bind.TransactOpts, backend Backend, contractAddress common.Address, role Role, account common.Address, feeOpts FeeOptions) (*types.Transaction, error) {
+	return transactManaged(auth, backend, contractAddress, feeOpts, "revokeRole", role.Hash, account)
+}
+
+// transactManaged fills the transaction signer fields for the call of the
+// given method of the managed token contract and sends the transaction.
+// Reverts, e.g. because the signer lacks a role, are decoded. The
+// transaction is committed, if the given backend is a simulated backend.
+func transactManaged(auth *bind.TransactOpts, backend Backend, contractAddress common.Address, feeOpts FeeOptions, method string, args ...interface{}) (*types.Transaction, error) {
+	if _, err := GetManagedContract(backend, contractAddress); err != nil {
+		return nil, err
+	}
+	managedABI, err := GetManagedABI()
+	if err != nil {
+		return nil, err
+	}
+
+	callData, err := managedABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   &contractAddress,
+		Data: callData,
+	}
+	auth, err = FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := TransactMethod(auth, backend, managedABI, contractAddress, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit transaction on simulated backend
+	Commit(backend)
+
+	return tx, nil
+}
+
+// GetRoles returns the roles of the managed token contract, which the
+// account has.
+func GetRoles(opts *bind.CallOpts, contract *maltcoin.ManagedMaltcoin, account common.Address) ([]Role, error) {
+	var roles []Role
+	for _, role := range ManagedRoles() {
+		has, err := contract.HasRole(opts, role.Hash, account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s role: %w", role.Name, err)
+		}
+		if has {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles, nil
+}
+
+// RoleMember is an account, which has a role.
+type RoleMember struct {
+	Role    Role
+	Account common.Address
+}
+
+// roleChange is a RoleGranted or RoleRevoked event.
+type roleChange struct {
+	log     types.Log
+	member  RoleMember
+	granted bool
+}
+
+// GetRoleMembers returns the current members of all roles by replaying the
+// RoleGranted and RoleRevoked events in the given block range, because
+// AccessControl does not enumerate the members. The members are sorted by
+// role and account.
+func GetRoleMembers(opts *bind.FilterOpts, contract *maltcoin.ManagedMaltcoin) ([]RoleMember, error) {
+	var changes []roleChange
+
+	granted, err := contract.FilterRoleGranted(opts, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer granted.Close()
+	for granted.Next() {
+		event := granted.Event
+		changes = append(changes, roleChange{event.Raw, RoleMember{RoleByHash(event.Role), event.Account}, true})
+	}
+	if err := granted.Error(); err != nil {
+		return nil, err
+	}
+
+	revoked, err := contract.FilterRoleRevoked(opts, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer revoked.Close()
+	for revoked.Next() {
+		event := revoked.Event
+		changes = append(changes, roleChange{event.Raw, RoleMember{RoleByHash(event.Role), event.Account}, false})
+	}
+	if err := revoked.Error(); err != nil {
+		return nil, err
+	}
+
+	// Replay the events in the order, in which they were emitted
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].log.BlockNumber != changes[j].log.BlockNumber {
+			return changes[i].log.BlockNumber < changes[j].log.BlockNumber
+		}
+		return changes[i].log.Index < changes[j].log.Index
+	})
+	current := make(map[RoleMember]bool)
+	for _, change := range changes {
+		if change.granted {
+			current[change.member] = true
+		} else {
+			delete(current, change.member)
+		}
+	}
+
+	members := make([]RoleMember, 0, len(current))
+	for member := range current {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Role.Hash != members[j].Role.Hash {
+			return members[i].Role.Hash.Hex() < members[j].Role.Hash.Hex()
+		}
+		return members[i].Account.Hex() < members[j].Account.Hex()
+	})
+
+	return members, nil
+}
@@ -0,0 +1,135 @@
+// managed_test.go contains the unit tests for the managed variant of the
+// token contract.
+package util
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseRole tests parsing roles by name and hash.
+func TestParseRole(t *testing.T) {
+	customHash := common.HexToHash("0x2a")
+
+	testcases := []struct {
+		name   string
+		value  string
+		exp    Role
+		expErr bool
+	}{
+		{"passes - admin", "admin", AdminRole, false},
+		{"passes - case insensitive", "MINTER", MinterRole, false},
+		{"passes - known hash", PauserRole.Hash.Hex(), PauserRole, false},
+		{"passes - custom hash", customHash.Hex(), Role{Name: customHash.Hex(), Hash: customHash}, false},
+		{"fails - unknown name", "burner", Role{}, true},
+		{"fails - short hex", "0x2a", Role{}, true},
+		{"fails - hex without prefix", customHash.Hex()[2:], Role{}, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			role, err := ParseRole(tc.value)
+			if tc.expErr {
+				require.ErrorIs(t, err, ErrUnknownRole)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, role)
+		})
+	}
+}
+
+// TestManagedToken tests minting, burning, pausing and the administration
+// of roles of the managed token contract on a simulated backend.
+func TestManagedToken(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+
+	// Both accounts need funds to pay fees
+	funds := new(big.Int).Mul(big.NewInt(1000), Ten18)
+	client := &SimulatedClient{backends.NewSimulatedBackend(core.GenesisAlloc{
+		addresses[0]: {Balance: funds},
+		addresses[1]: {Balance: funds},
+	}, MaxGasPerBlock)}
+	auth, err := NewTransactionSigner(client, privKeys[0])
+	require.NoError(t, err, "Error creating transaction signer")
+	otherAuth, err := NewTransactionSigner(client, privKeys[1])
+	require.NoError(t, err, "Error creating transaction signer")
+	feeOpts := DefaultFeeOptions()
+
+	estimate, err := EstimateManagedDeployment(auth, client, DefaultTokenSettings(), common.Address{}, feeOpts)
+	require.NoError(t, err, "Error estimating deployment")
+	contractAddress, _, contract, err := DeployManagedContract(auth, client, DefaultTokenSettings(), common.Address{}, feeOpts)
+	require.NoError(t, err, "Error deploying managed contract")
+	require.Equal(t, *estimate.ContractAddress, contractAddress, "Predicted address should match")
+
+	roles, err := GetRoles(nil, contract, addresses[0])
+	require.NoError(t, err)
+	require.Equal(t, ManagedRoles(), roles, "Deployer should have all roles")
+	roles, err = GetRoles(nil, contract, addresses[1])
+	require.NoError(t, err)
+	require.Empty(t, roles, "Other account should have no roles")
+
+	// Mint and burn
+	amount := Ten18
+	_, err = MintTokens(auth, client, contractAddress, addresses[1], amount, feeOpts)
+	require.NoError(t, err, "Error minting tokens")
+	balance, err := contract.BalanceOf(nil, addresses[1])
+	require.NoError(t, err)
+	require.Equal(t, amount, balance)
+
+	_, err = BurnTokens(auth, client, contractAddress, amount, feeOpts)
+	require.NoError(t, err, "Error burning tokens")
+	totalSupply, err := contract.TotalSupply(nil)
+	require.NoError(t, err)
+	require.Zero(t, DefaultTokenSettings().InitialSupply.Cmp(totalSupply), "Burn should reduce the supply minted before")
+
+	// The other account lacks the minter role
+	_, err = MintTokens(otherAuth, client, contractAddress, addresses[1], amount, feeOpts)
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.ErrorContains(t, err, "is missing role "+MinterRole.Hash.Hex())
+
+	// Pause blocks transfers until unpaused
+	_, err = PauseToken(auth, client, contractAddress, feeOpts)
+	require.NoError(t, err, "Error pausing token")
+	paused, err := contract.Paused(nil)
+	require.NoError(t, err)
+	require.True(t, paused)
+	_, err = TransferTokens(auth, client, contractAddress, addresses[1], amount, feeOpts)
+	require.ErrorContains(t, err, "ERC20Pausable: token transfer while paused")
+	_, err = UnpauseToken(auth, client, contractAddress, feeOpts)
+	require.NoError(t, err, "Error unpausing token")
+	_, err = TransferTokens(auth, client, contractAddress, addresses[1], amount, feeOpts)
+	require.NoError(t, err, "Transfer should succeed after unpausing")
+
+	// Grant and revoke roles
+	_, err = GrantRole(auth, client, contractAddress, MinterRole, addresses[1], feeOpts)
+	require.NoError(t, err, "Error granting role")
+	_, err = GrantRole(auth, client, contractAddress, PauserRole, addresses[1], feeOpts)
+	require.NoError(t, err, "Error granting role")
+	_, err = RevokeRole(auth, client, contractAddress, PauserRole, addresses[1], feeOpts)
+	require.NoError(t, err, "Error revoking role")
+	_, err = MintTokens(otherAuth, client, contractAddress, addresses[1], amount, feeOpts)
+	require.NoError(t, err, "Minter should mint")
+
+	members, err := GetRoleMembers(&bind.FilterOpts{Context: context.Background()}, contract)
+	require.NoError(t, err)
+	expMembers := []RoleMember{
+		{AdminRole, addresses[0]},
+		{MinterRole, addresses[0]},
+		{MinterRole, addresses[1]},
+		{PauserRole, addresses[0]},
+	}
+	require.ElementsMatch(t, expMembers, members)
+
+	totalSupply, err = contract.TotalSupply(nil)
+	require.NoError(t, err)
+	require.Zero(t, new(big.Int).Add(DefaultTokenSettings().InitialSupply, amount).Cmp(totalSupply))
+}
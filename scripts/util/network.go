@@ -0,0 +1,331 @@
+// network.go contains the network profiles, which define the blockchain node
+// the scripts connect to. Profiles are loaded from a JSON configuration file
+// and can be overridden using environment variables and command line flags.
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultNetworkConfigFile is the configuration file, which is used if it
+	// is present in the working directory and no other file is specified.
+	DefaultNetworkConfigFile = "networks.json"
+
+	// DefaultNetworkName is the profile, which is used if no other profile
+	// is selected in the configuration file, environment or flags.
+	DefaultNetworkName = "local"
+)
+
+// Environment variables, which override the network settings.
+const (
+	EnvNetworkConfig     = "MALTCOIN_CONFIG"
+	EnvNetwork           = "MALTCOIN_NETWORK"
+	EnvRPCURL            = "MALTCOIN_RPC_URL"
+	EnvChainID           = "MALTCOIN_CHAIN_ID"
+	EnvGasStrategy       = "MALTCOIN_GAS_STRATEGY"
+	EnvConfirmations     = "MALTCOIN_CONFIRMATIONS"
+	EnvBaseFeeMultiplier = "MALTCOIN_BASE_FEE_MULTIPLIER"
+)
+
+var (
+	// ErrChainIDMismatch is returned, when the chain ID reported by a node
+	// differs from the chain ID of the selected network profile.
+	ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+	// ErrUnknownNetwork is returned, when a network profile is selected,
+	// which is not defined.
+	ErrUnknownNetwork = errors.New("unknown network")
+)
+
+// NetworkProfile defines the connection settings for a blockchain node.
+type NetworkProfile struct {
+	// Name of the profile
+	Name string `json:"name"`
+	// URL of the JSON-RPC endpoint of the node
+	URL string `json:"url"`
+	// ChainID is the chain ID, which the node is expected to report.
+	// If it is nil, any chain ID is accepted.
+	ChainID *big.Int `json:"chain_id,omitempty"`
+	// GasStrategy defines how the fees for transactions are determined.
+	GasStrategy string `json:"gas_strategy,omitempty"`
+	// BaseFeeMultiplier is the factor, by which the base fee is multiplied
+	// to calculate the fee cap of dynamic fee transactions.
+	BaseFeeMultiplier float64 `json:"base_fee_multiplier,omitempty"`
+	// Confirmations is the number of blocks, that have to be produced
+	// on top of a transaction, before it is considered final.
+	Confirmations uint64 `json:"confirmations,omitempty"`
+	// Factory is the address of the Create2Factory contract, which is used
+	// for deterministic deployments on the network.
+	Factory string `json:"factory,omitempty"`
+}
+
+// NetworkConfig contains the network profiles, that are available to
+// the scripts, and the name of the default profile.
+type NetworkConfig struct {
+	Default  string                    `json:"default"`
+	Networks map[string]NetworkProfile `json:"networks"`
+}
+
+// DefaultNetworkConfig returns the built-in network configuration, which
+// only contains the local Evmos node.
+func DefaultNetworkConfig() *NetworkConfig {
+	return &NetworkConfig{
+		Default: DefaultNetworkName,
+		Networks: map[string]NetworkProfile{
+			DefaultNetworkName: {
+				Name: DefaultNetworkName,
+				// Evmos serves on port 8545 out of the box
+				URL:               "http://localhost:8545",
+				ChainID:           big.NewInt(9000),
+				GasStrategy:       GasStrategyAuto,
+				BaseFeeMultiplier: DefaultBaseFeeMultiplier,
+				Confirmations:     1,
+			},
+		},
+	}
+}
+
+// LoadNetworkConfig reads the network configuration from the given JSON
+// file. The profiles in the file are added to the built-in profiles,
+// replacing those with the same name.
+func LoadNetworkConfig(path string) (*NetworkConfig, error) {
+	config := DefaultNetworkConfig()
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileConfig NetworkConfig
+	if err := json.Unmarshal(bz, &fileConfig); err != nil {
+		return nil, fmt.Errorf("invalid network configuration in %s: %w", path, err)
+	}
+
+	if fileConfig.Default != "" {
+		config.Default = fileConfig.Default
+	}
+	for name, profile := range fileConfig.Networks {
+		profile.Name = name
+		config.Networks[name] = profile
+	}
+
+	return config, nil
+}
+
+// Profile returns the network profile with the given name. If the name is
+// empty, the default profile is returned.
+func (c *NetworkConfig) Profile(name string) (NetworkProfile, error) {
+	if name == "" {
+		name = c.Default
+	}
+
+	profile, ok := c.Networks[name]
+	if !ok {
+		return NetworkProfile{}, fmt.Errorf("%w %q (available: %s)", ErrUnknownNetwork, name, strings.Join(c.Names(), ", "))
+	}
+
+	if profile.GasStrategy == "" {
+		profile.GasStrategy = GasStrategyAuto
+	}
+	if profile.BaseFeeMultiplier == 0 {
+		profile.BaseFeeMultiplier = DefaultBaseFeeMultiplier
+	}
+	if profile.Confirmations == 0 {
+		profile.Confirmations = 1
+	}
+
+	return profile, nil
+}
+
+// Names returns the sorted names of all available network profiles.
+func (c *NetworkConfig) Names() []string {
+	names := make([]string, 0, len(c.Networks))
+	for name := range c.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Validate checks if the network profile contains all necessary
+// information to connect to a node.
+func (p NetworkProfile) Validate() error {
+	if p.URL == "" {
+		return fmt.Errorf("network %q has no URL", p.Name)
+	}
+
+	switch p.GasStrategy {
+	case GasStrategyAuto, GasStrategyDynamic, GasStrategyLegacy:
+	default:
+		return fmt.Errorf("network %q has invalid gas strategy %q", p.Name, p.GasStrategy)
+	}
+
+	if p.BaseFeeMultiplier < 1 {
+		return fmt.Errorf("network %q has invalid base fee multiplier %v, must be at least 1", p.Name, p.BaseFeeMultiplier)
+	}
+
+	if p.Factory != "" && !common.IsHexAddress(p.Factory) {
+		return fmt.Errorf("network %q has invalid factory address %q", p.Name, p.Factory)
+	}
+
+	return nil
+}
+
+// FeeOptions returns the options to determine transaction fees on the
+// network.
+func (p NetworkProfile) FeeOptions() FeeOptions {
+	return FeeOptions{
+		Strategy:          p.GasStrategy,
+		BaseFeeMultiplier: p.BaseFeeMultiplier,
+	}
+}
+
+// CheckChainID compares the given chain ID, which was reported by a node,
+// with the expected chain ID of the profile.
+func (p NetworkProfile) CheckChainID(chainID *big.Int) error {
+	if p.ChainID == nil || p.ChainID.Cmp(chainID) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: network %q expects %v, but node at %s reports %v", ErrChainIDMismatch, p.Name, p.ChainID, p.URL, chainID)
+}
+
+// override sets the profile field, that corresponds to the given key,
+// to the given string value.
+func (p *NetworkProfile) override(key, value string) error {
+	switch key {
+	case "url":
+		p.URL = value
+	case "chain-id":
+		chainID, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return fmt.Errorf("invalid chain ID %q", value)
+		}
+		p.ChainID = chainID
+	case "gas-strategy":
+		p.GasStrategy = value
+	case "base-fee-multiplier":
+		multiplier, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid base fee multiplier %q", value)
+		}
+		p.BaseFeeMultiplier = multiplier
+	case "confirmations":
+		confirmations, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number of confirmations %q", value)
+		}
+		p.Confirmations = confirmations
+	default:
+		return fmt.Errorf("unknown network setting %q", key)
+	}
+
+	return nil
+}
+
+// NetworkFlags holds the command line flags, which are used to select
+// and override the network profile.
+type NetworkFlags struct {
+	config            string
+	network           string
+	url               string
+	chainID           string
+	gasStrategy       string
+	baseFeeMultiplier string
+	confirmations     string
+}
+
+// AddNetworkFlags registers the network flags with the given flag set.
+func AddNetworkFlags(fs *flag.FlagSet) *NetworkFlags {
+	nf := &NetworkFlags{}
+
+	fs.StringVar(&nf.config, "config", "", "path to the network configuration file (env "+EnvNetworkConfig+", default ./"+DefaultNetworkConfigFile+" if present)")
+	fs.StringVar(&nf.network, "network", "", "name of the network profile to use (env "+EnvNetwork+")")
+	fs.StringVar(&nf.url, "rpc-url", "", "override the JSON-RPC URL of the profile (env "+EnvRPCURL+")")
+	fs.StringVar(&nf.chainID, "chain-id", "", "override the expected chain ID of the profile (env "+EnvChainID+")")
+	fs.StringVar(&nf.gasStrategy, "gas-strategy", "", "override the gas strategy of the profile: auto, dynamic or legacy (env "+EnvGasStrategy+")")
+	fs.StringVar(&nf.baseFeeMultiplier, "base-fee-multiplier", "", "override the factor, by which the base fee is multiplied for the fee cap (env "+EnvBaseFeeMultiplier+")")
+	fs.StringVar(&nf.confirmations, "confirmations", "", "override the required confirmations of the profile (env "+EnvConfirmations+")")
+
+	return nf
+}
+
+// Profile loads the network configuration and returns the selected profile.
+// Settings are applied in the following order, where later ones take
+// precedence: configuration file, environment variables, command line flags.
+func (nf *NetworkFlags) Profile() (NetworkProfile, error) {
+	config := DefaultNetworkConfig()
+
+	configPath := firstNonEmpty(nf.config, os.Getenv(EnvNetworkConfig))
+	if configPath == "" {
+		if _, err := os.Stat(DefaultNetworkConfigFile); err == nil {
+			configPath = DefaultNetworkConfigFile
+		}
+	}
+	if configPath != "" {
+		var err error
+		config, err = LoadNetworkConfig(configPath)
+		if err != nil {
+			return NetworkProfile{}, err
+		}
+	}
+
+	profile, err := config.Profile(firstNonEmpty(nf.network, os.Getenv(EnvNetwork)))
+	if err != nil {
+		return NetworkProfile{}, err
+	}
+
+	overrides := []struct {
+		key      string
+		envVar   string
+		flagName string
+		value    string
+	}{
+		{"url", EnvRPCURL, "rpc-url", nf.url},
+		{"chain-id", EnvChainID, "chain-id", nf.chainID},
+		{"gas-strategy", EnvGasStrategy, "gas-strategy", nf.gasStrategy},
+		{"base-fee-multiplier", EnvBaseFeeMultiplier, "base-fee-multiplier", nf.baseFeeMultiplier},
+		{"confirmations", EnvConfirmations, "confirmations", nf.confirmations},
+	}
+	for _, o := range overrides {
+		if value := os.Getenv(o.envVar); value != "" {
+			if err := profile.override(o.key, value); err != nil {
+				return NetworkProfile{}, fmt.Errorf("%s: %w", o.envVar, err)
+			}
+		}
+		if o.value != "" {
+			if err := profile.override(o.key, o.value); err != nil {
+				return NetworkProfile{}, fmt.Errorf("--%s: %w", o.flagName, err)
+			}
+		}
+	}
+
+	if err := profile.Validate(); err != nil {
+		return NetworkProfile{}, err
+	}
+
+	return profile, nil
+}
+
+// firstNonEmpty returns the first of the given strings, which is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,256 @@
+// network_test.go contains the unit tests for the network profiles.
+package util
+
+import (
+	"flag"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testNetworkConfig is written to a temporary configuration file
+// for the tests.
+const testNetworkConfig = `{
+	"default": "devnet",
+	"networks": {
+		"devnet": {
+			"url": "http://devnet:8545",
+			"chain_id": 9000,
+			"confirmations": 2
+		},
+		"staging": {
+			"url": "http://staging:8545",
+			"chain_id": 9001,
+			"gas_strategy": "legacy",
+			"confirmations": 5,
+			"factory": "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+		}
+	}
+}`
+
+// writeTestNetworkConfig writes the test network configuration to a
+// temporary file and returns its path.
+func writeTestNetworkConfig(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "networks.json")
+	err := os.WriteFile(path, []byte(testNetworkConfig), 0o600)
+	require.NoError(t, err, "Error writing network configuration")
+
+	return path
+}
+
+// TestLoadNetworkConfig tests if network profiles are correctly read
+// from a configuration file and merged with the built-in profiles.
+func TestLoadNetworkConfig(t *testing.T) {
+	config, err := LoadNetworkConfig(writeTestNetworkConfig(t))
+	require.NoError(t, err, "Error loading network configuration")
+	require.Equal(t, []string{"devnet", "local", "staging"}, config.Names(), "Wrong network names")
+
+	testcases := []struct {
+		name          string
+		expErr        bool
+		network       string
+		url           string
+		confirmations uint64
+		gasStrategy   string
+		factory       string
+	}{
+		{
+			"passes - default network from file",
+			false,
+			"",
+			"http://devnet:8545",
+			2,
+			GasStrategyAuto,
+			"",
+		},
+		{
+			"passes - built-in network",
+			false,
+			"local",
+			"http://localhost:8545",
+			1,
+			GasStrategyAuto,
+			"",
+		},
+		{
+			"passes - network from file",
+			false,
+			"staging",
+			"http://staging:8545",
+			5,
+			GasStrategyLegacy,
+			"0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		},
+		{
+			"fails - unknown network",
+			true,
+			"mainnet",
+			"",
+			0,
+			"",
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			profile, err := config.Profile(tc.network)
+			if tc.expErr {
+				require.ErrorIs(t, err, ErrUnknownNetwork, "Unknown network should raise an error")
+			} else {
+				require.NoError(t, err, "Error getting network profile")
+				require.Equal(t, tc.url, profile.URL, "Wrong URL")
+				require.Equal(t, tc.confirmations, profile.Confirmations, "Wrong confirmations")
+				require.Equal(t, tc.gasStrategy, profile.GasStrategy, "Wrong gas strategy")
+				require.Equal(t, tc.factory, profile.Factory, "Wrong factory")
+				require.NoError(t, profile.Validate(), "Profile should be valid")
+				require.Equal(t, DefaultBaseFeeMultiplier, profile.BaseFeeMultiplier, "Wrong base fee multiplier")
+			}
+		})
+	}
+}
+
+// TestNetworkFlags tests the precedence of the configuration file,
+// environment variables and command line flags.
+func TestNetworkFlags(t *testing.T) {
+	configPath := writeTestNetworkConfig(t)
+
+	testcases := []struct {
+		name    string
+		expErr  bool
+		env     map[string]string
+		args    []string
+		url     string
+		chainID int64
+	}{
+		{
+			"passes - profile from flag",
+			false,
+			nil,
+			[]string{"--config", configPath, "--network", "staging"},
+			"http://staging:8545",
+			9001,
+		},
+		{
+			"passes - profile and config from environment",
+			false,
+			map[string]string{EnvNetworkConfig: configPath, EnvNetwork: "staging"},
+			nil,
+			"http://staging:8545",
+			9001,
+		},
+		{
+			"passes - flag overrides environment",
+			false,
+			map[string]string{EnvRPCURL: "http://env:8545", EnvChainID: "1"},
+			[]string{"--config", configPath, "--rpc-url", "http://flag:8545"},
+			"http://flag:8545",
+			1,
+		},
+		{
+			"fails - invalid chain ID",
+			true,
+			nil,
+			[]string{"--config", configPath, "--chain-id", "abc"},
+			"",
+			0,
+		},
+		{
+			"fails - invalid gas strategy",
+			true,
+			map[string]string{EnvGasStrategy: "free"},
+			[]string{"--config", configPath},
+			"",
+			0,
+		},
+		{
+			"fails - base fee multiplier below one",
+			true,
+			nil,
+			[]string{"--config", configPath, "--base-fee-multiplier", "0.5"},
+			"",
+			0,
+		},
+		{
+			"fails - missing config file",
+			true,
+			nil,
+			[]string{"--config", filepath.Join(t.TempDir(), "missing.json")},
+			"",
+			0,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, key := range []string{EnvNetworkConfig, EnvNetwork, EnvRPCURL, EnvChainID, EnvGasStrategy, EnvBaseFeeMultiplier, EnvConfirmations} {
+				t.Setenv(key, tc.env[key])
+			}
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			nf := AddNetworkFlags(fs)
+			require.NoError(t, fs.Parse(tc.args), "Error parsing flags")
+
+			profile, err := nf.Profile()
+			if tc.expErr {
+				require.Error(t, err, "Invalid settings should raise an error")
+			} else {
+				require.NoError(t, err, "Error getting network profile")
+				require.Equal(t, tc.url, profile.URL, "Wrong URL")
+				require.Equal(t, big.NewInt(tc.chainID), profile.ChainID, "Wrong chain ID")
+			}
+		})
+	}
+}
+
+// TestGetClientChainIDCheck tests that connecting to a node, which reports
+// a chain ID different from the network profile, fails.
+func TestGetClientChainIDCheck(t *testing.T) {
+	// Serve a JSON-RPC endpoint, which reports chain ID 9000 (0x2328).
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x2328"}`))
+	}))
+	defer server.Close()
+
+	testcases := []struct {
+		name    string
+		expErr  bool
+		chainID *big.Int
+	}{
+		{
+			"passes - matching chain ID",
+			false,
+			big.NewInt(9000),
+		},
+		{
+			"passes - no expected chain ID",
+			false,
+			nil,
+		},
+		{
+			"fails - different chain ID",
+			true,
+			big.NewInt(9001),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			profile := NetworkProfile{Name: "test", URL: server.URL, ChainID: tc.chainID}
+
+			client, err := GetClient(profile)
+			if tc.expErr {
+				require.ErrorIs(t, err, ErrChainIDMismatch, "Chain ID mismatch should raise an error")
+			} else {
+				require.NoError(t, err, "Error getting client")
+				client.Close()
+			}
+		})
+	}
+}
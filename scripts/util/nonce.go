@@ -0,0 +1,289 @@
+// nonce.go contains a nonce manager, which hands out the nonces for
+// transactions of multiple sender addresses from local counters. This
+// allows sending several transactions in quick succession or from
+// multiple goroutines, without querying the node for the pending nonce
+// before every transaction.
+package util
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// maxNonceRetries defines how often a transaction is retried with a new
+// nonce, after the node rejected the previous one.
+const maxNonceRetries = 3
+
+// nonceErrors contains the error messages, with which nodes reject
+// transactions because of an outdated nonce. The errors are matched by
+// their message, because they are returned as strings via JSON-RPC.
+var nonceErrors = []string{
+	"nonce too low",
+	"invalid transaction nonce", // returned by the simulated backend
+}
+
+// alreadyKnownError is the error message, with which nodes reject a
+// transaction, which is already contained in their transaction pool.
+const alreadyKnownError = "already known"
+
+// NonceBackend defines an interface, which can be used to get the pending
+// nonce of an account for a given ethclient or simulated backend.
+type NonceBackend interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager hands out nonces per sender address from a local counter.
+// The counter is initialized with the pending nonce from the node, when the
+// first nonce for an address is requested, and resynchronized whenever the
+// node rejects a nonce. It is safe for concurrent use.
+type NonceManager struct {
+	backend NonceBackend
+
+	mu       sync.Mutex
+	accounts map[common.Address]*accountNonces
+}
+
+// accountNonces contains the nonce state of a single sender address.
+type accountNonces struct {
+	mu sync.Mutex
+
+	synced bool
+	// next is the next nonce, which was not yet handed out.
+	next uint64
+	// inFlight contains the nonces, which were handed out but were neither
+	// marked as sent nor released yet.
+	inFlight map[uint64]bool
+	// released contains the nonces below next in ascending order, which
+	// are not used by any transaction. They are handed out again first.
+	released []uint64
+}
+
+// NewNonceManager creates a nonce manager, which uses the given backend to
+// synchronize the nonces with the node.
+func NewNonceManager(backend NonceBackend) *NonceManager {
+	return &NonceManager{
+		backend:  backend,
+		accounts: make(map[common.Address]*accountNonces),
+	}
+}
+
+// account returns the nonce state of the given address.
+func (m *NonceManager) account(address common.Address) *accountNonces {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	acc, ok := m.accounts[address]
+	if !ok {
+		acc = &accountNonces{inFlight: make(map[uint64]bool)}
+		m.accounts[address] = acc
+	}
+
+	return acc
+}
+
+// Next returns the next nonce for a transaction of the given address.
+// Released nonces are handed out again, before the counter is increased.
+// The nonce has to be passed to either Sent or Release afterwards.
+func (m *NonceManager) Next(ctx context.Context, address common.Address) (uint64, error) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	if !acc.synced {
+		if err := m.resync(ctx, address, acc); err != nil {
+			return 0, err
+		}
+	}
+
+	var nonce uint64
+	if len(acc.released) > 0 {
+		nonce, acc.released = acc.released[0], acc.released[1:]
+	} else {
+		nonce = acc.next
+		acc.next++
+	}
+	acc.inFlight[nonce] = true
+
+	return nonce, nil
+}
+
+// Sent marks the given nonce as used by a transaction, which was broadcast
+// to the node.
+func (m *NonceManager) Sent(address common.Address, nonce uint64) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	delete(acc.inFlight, nonce)
+}
+
+// Release returns a nonce, which was handed out for a transaction that was
+// never broadcast, so that it is used for the next transaction.
+func (m *NonceManager) Release(address common.Address, nonce uint64) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	if !acc.inFlight[nonce] {
+		return
+	}
+	delete(acc.inFlight, nonce)
+	acc.release(nonce)
+}
+
+// Resync resets the local counter of the given address to the pending nonce
+// reported by the node. Nonces between the pending nonce and the local
+// counter, which are not in flight, belong to transactions the node does
+// not know about and are released, so that the gaps are filled again.
+func (m *NonceManager) Resync(ctx context.Context, address common.Address) error {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	return m.resync(ctx, address, acc)
+}
+
+// resync implements Resync for an already locked account.
+func (m *NonceManager) resync(ctx context.Context, address common.Address, acc *accountNonces) error {
+	pending, err := m.backend.PendingNonceAt(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	// Nonces in flight must not be handed out twice
+	next := pending
+	for nonce := range acc.inFlight {
+		if nonce >= next {
+			next = nonce + 1
+		}
+	}
+
+	acc.released = acc.released[:0]
+	for nonce := pending; nonce < next; nonce++ {
+		if !acc.inFlight[nonce] {
+			acc.released = append(acc.released, nonce)
+		}
+	}
+	acc.next = next
+	acc.synced = true
+
+	return nil
+}
+
+// Gaps returns the nonces below the local counter of the given address,
+// which are not used by any transaction. Transactions with higher nonces
+// are not executed by the node, until these gaps are filled.
+func (m *NonceManager) Gaps(address common.Address) []uint64 {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	return append([]uint64{}, acc.released...)
+}
+
+// Send obtains the next nonce for the signer, sets it on a copy of the
+// transaction signer and calls the given function, which sends the
+// transaction, e.g. using a contract binding. If the node rejects the nonce,
+// the counter is resynchronized and the transaction is retried with a new
+// nonce. If the node reports the signed transaction as already known, it
+// was broadcast before and is returned as sent, because it will still be
+// executed. If sending fails otherwise, the nonce is released.
+func (m *NonceManager) Send(ctx context.Context, auth *bind.TransactOpts, send func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	for attempt := 0; ; attempt++ {
+		nonce, err := m.Next(ctx, auth.From)
+		if err != nil {
+			return nil, err
+		}
+
+		// The signed transaction is kept, because contract bindings do
+		// not return it, when broadcasting fails
+		var signed *types.Transaction
+		opts := *auth
+		opts.Nonce = new(big.Int).SetUint64(nonce)
+		if auth.Signer != nil {
+			opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				signedTx, err := auth.Signer(address, tx)
+				if err == nil {
+					signed = signedTx
+				}
+				return signedTx, err
+			}
+		}
+		tx, err := send(&opts)
+		if err == nil {
+			m.Sent(auth.From, nonce)
+			return tx, nil
+		}
+		if IsAlreadyKnown(err) && signed != nil {
+			m.Sent(auth.From, nonce)
+			return signed, nil
+		}
+
+		m.Release(auth.From, nonce)
+		if !IsNonceError(err) || attempt == maxNonceRetries {
+			return nil, err
+		}
+		if err := m.Resync(ctx, auth.From); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// release adds the given nonce to the released nonces. If it is the highest
+// nonce handed out, the counter is decreased instead.
+func (acc *accountNonces) release(nonce uint64) {
+	if nonce >= acc.next {
+		return
+	}
+
+	idx := sort.Search(len(acc.released), func(i int) bool { return acc.released[i] >= nonce })
+	if idx < len(acc.released) && acc.released[idx] == nonce {
+		return
+	}
+	acc.released = append(acc.released, 0)
+	copy(acc.released[idx+1:], acc.released[idx:])
+	acc.released[idx] = nonce
+
+	// Shrink the counter, while the highest nonces are released
+	for len(acc.released) > 0 && acc.released[len(acc.released)-1] == acc.next-1 {
+		acc.released = acc.released[:len(acc.released)-1]
+		acc.next--
+	}
+}
+
+// IsNonceError returns true, if the given error was returned by the node,
+// because the nonce of the transaction was already used by another
+// transaction.
+func IsNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, nonceErr := range nonceErrors {
+		if strings.Contains(msg, nonceErr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsAlreadyKnown returns true, if the given error was returned by the node,
+// because the transaction is already contained in its transaction pool.
+// The transaction was broadcast before and must not be signed again with
+// another nonce, because it will still be executed.
+func IsAlreadyKnown(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(err.Error()), alreadyKnownError)
+}
@@ -0,0 +1,299 @@
+// nonce_test.go contains the unit tests for the nonce manager.
+package util
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockNonceBackend returns a fixed pending nonce for all accounts.
+type mockNonceBackend struct {
+	mu      sync.Mutex
+	pending uint64
+	calls   int
+}
+
+func (b *mockNonceBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.calls++
+	return b.pending, nil
+}
+
+// TestNonceManager tests handing out, releasing and resynchronizing
+// nonces for a single address.
+func TestNonceManager(t *testing.T) {
+	address := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	testcases := []struct {
+		name     string
+		run      func(m *NonceManager, backend *mockNonceBackend)
+		expNext  uint64
+		expGaps  []uint64
+		expSyncs int
+	}{
+		{
+			"passes - counter starts at pending nonce",
+			func(m *NonceManager, _ *mockNonceBackend) {
+				for i := 0; i < 3; i++ {
+					nonce, _ := m.Next(context.Background(), address)
+					m.Sent(address, nonce)
+				}
+			},
+			8,
+			[]uint64{},
+			1,
+		},
+		{
+			"passes - releasing highest nonce decreases counter",
+			func(m *NonceManager, _ *mockNonceBackend) {
+				nonce, _ := m.Next(context.Background(), address)
+				m.Sent(address, nonce)
+				nonce, _ = m.Next(context.Background(), address)
+				m.Release(address, nonce)
+			},
+			6,
+			[]uint64{},
+			1,
+		},
+		{
+			"passes - released nonce leaves gap, which is filled first",
+			func(m *NonceManager, _ *mockNonceBackend) {
+				first, _ := m.Next(context.Background(), address)
+				second, _ := m.Next(context.Background(), address)
+				m.Sent(address, second)
+				m.Release(address, first)
+				require.Equal(t, []uint64{first}, m.Gaps(address), "Released nonce should be a gap")
+			},
+			5,
+			[]uint64{},
+			1,
+		},
+		{
+			"passes - nonces are only released once",
+			func(m *NonceManager, _ *mockNonceBackend) {
+				first, _ := m.Next(context.Background(), address)
+				second, _ := m.Next(context.Background(), address)
+				m.Sent(address, second)
+				m.Release(address, first)
+				m.Release(address, first)
+				m.Release(address, second)
+			},
+			5,
+			[]uint64{},
+			1,
+		},
+		{
+			"passes - resync after transactions sent by someone else",
+			func(m *NonceManager, backend *mockNonceBackend) {
+				nonce, _ := m.Next(context.Background(), address)
+				m.Sent(address, nonce)
+				backend.pending = 10
+				require.NoError(t, m.Resync(context.Background(), address))
+			},
+			10,
+			[]uint64{},
+			2,
+		},
+		{
+			"passes - resync detects dropped transactions as gaps",
+			func(m *NonceManager, backend *mockNonceBackend) {
+				for i := 0; i < 3; i++ {
+					nonce, _ := m.Next(context.Background(), address)
+					m.Sent(address, nonce)
+				}
+				inFlight, _ := m.Next(context.Background(), address)
+				require.Equal(t, uint64(8), inFlight)
+
+				// Only the first transaction reached the node
+				backend.pending = 6
+				require.NoError(t, m.Resync(context.Background(), address))
+				require.Equal(t, []uint64{6, 7}, m.Gaps(address), "Dropped nonces should be gaps")
+
+				m.Sent(address, inFlight)
+			},
+			6,
+			[]uint64{7},
+			2,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &mockNonceBackend{pending: 5}
+			m := NewNonceManager(backend)
+
+			tc.run(m, backend)
+
+			// The next nonce is the first gap or the counter and the
+			// remaining gaps are reported
+			nonce, err := m.Next(context.Background(), address)
+			require.NoError(t, err, "Error getting next nonce")
+			require.Equal(t, tc.expNext, nonce, "Wrong next nonce")
+			require.Equal(t, tc.expSyncs, backend.calls, "Wrong number of nonce queries")
+			m.Sent(address, nonce)
+			require.Equal(t, tc.expGaps, m.Gaps(address), "Wrong gaps")
+		})
+	}
+}
+
+// TestNonceManagerConcurrency tests, that concurrently requested nonces
+// are unique and consecutive.
+func TestNonceManagerConcurrency(t *testing.T) {
+	const n = 50
+
+	_, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating addresses")
+
+	m := NewNonceManager(&mockNonceBackend{})
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		nonces = make(map[common.Address]map[uint64]bool)
+	)
+	for _, address := range addresses {
+		nonces[address] = make(map[uint64]bool)
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(address common.Address) {
+				defer wg.Done()
+
+				nonce, err := m.Next(context.Background(), address)
+				require.NoError(t, err, "Error getting next nonce")
+				m.Sent(address, nonce)
+
+				mu.Lock()
+				defer mu.Unlock()
+				require.False(t, nonces[address][nonce], "Nonce %d was handed out twice", nonce)
+				nonces[address][nonce] = true
+			}(address)
+		}
+	}
+	wg.Wait()
+
+	for _, address := range addresses {
+		for i := uint64(0); i < n; i++ {
+			require.True(t, nonces[address][i], "Nonce %d was not handed out", i)
+		}
+	}
+}
+
+// TestNonceManagerSend tests sending transactions on a simulated backend,
+// where a transaction sent outside of the nonce manager requires a resync.
+func TestNonceManagerSend(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client and transaction signer")
+
+	_, _, contract, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	m := NewNonceManager(client)
+	transfer := func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.Transfer(opts, addresses[1], big.NewInt(1))
+	}
+
+	tx, err := m.Send(context.Background(), auth, transfer)
+	require.NoError(t, err, "Error sending first transfer")
+	require.Equal(t, uint64(1), tx.Nonce(), "Wrong nonce of first transfer")
+	require.Nil(t, auth.Nonce, "Nonce of the original signer should not be changed")
+	client.Commit()
+
+	// Send a transaction with the next nonce outside of the nonce manager
+	opts := *auth
+	opts.Nonce = big.NewInt(2)
+	_, err = contract.Transfer(&opts, addresses[1], big.NewInt(1))
+	require.NoError(t, err, "Error sending transfer outside of nonce manager")
+	client.Commit()
+
+	// The rejected nonce leads to a resync and a retry
+	tx, err = m.Send(context.Background(), auth, transfer)
+	require.NoError(t, err, "Error sending transfer after external transaction")
+	require.Equal(t, uint64(3), tx.Nonce(), "Wrong nonce after resync")
+	client.Commit()
+
+	// Other errors release the nonce
+	_, err = m.Send(context.Background(), auth, func(*bind.TransactOpts) (*types.Transaction, error) {
+		return nil, errors.New("insufficient funds")
+	})
+	require.Error(t, err, "Error of send function should be returned")
+	nonce, err := m.Next(context.Background(), auth.From)
+	require.NoError(t, err, "Error getting next nonce")
+	require.Equal(t, uint64(4), nonce, "Released nonce should be handed out again")
+	m.Release(auth.From, nonce)
+
+	// A transaction, which the node already knows, was broadcast before and
+	// is not signed again with another nonce
+	var broadcast *types.Transaction
+	tx, err = m.Send(context.Background(), auth, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.NoSend = true
+		tx, err := contract.Transfer(opts, addresses[1], big.NewInt(1))
+		if err != nil {
+			return nil, err
+		}
+		broadcast = tx
+		if err := client.SendTransaction(context.Background(), tx); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("already known")
+	})
+	require.NoError(t, err, "Already known transaction should be treated as sent")
+	require.Equal(t, broadcast.Hash(), tx.Hash(), "Already known transaction should be returned")
+	client.Commit()
+	nonce, err = m.Next(context.Background(), auth.From)
+	require.NoError(t, err, "Error getting next nonce")
+	require.Equal(t, uint64(5), nonce, "Nonce of already known transaction should not be handed out again")
+
+	balance, err := contract.BalanceOf(nil, addresses[1])
+	require.NoError(t, err, "Error getting balance")
+	require.Equal(t, big.NewInt(4), balance, "Wrong balance of recipient")
+}
+
+// TestIsNonceError tests the detection of errors caused by used nonces.
+func TestIsNonceError(t *testing.T) {
+	testcases := []struct {
+		err      error
+		expected bool
+	}{
+		{errors.New("nonce too low"), true},
+		{errors.New("Nonce too low: address 0x12, tx: 1 state: 2"), true},
+		{errors.New("already known"), false},
+		{errors.New("invalid transaction nonce: got 1, want 2"), true},
+		{errors.New("insufficient funds for gas * price + value"), false},
+		{nil, false},
+	}
+
+	for _, tc := range testcases {
+		require.Equal(t, tc.expected, IsNonceError(tc.err), "Wrong result for %v", tc.err)
+	}
+}
+
+// TestIsAlreadyKnown tests the detection of errors caused by transactions,
+// which are already contained in the transaction pool of the node.
+func TestIsAlreadyKnown(t *testing.T) {
+	testcases := []struct {
+		err      error
+		expected bool
+	}{
+		{errors.New("already known"), true},
+		{errors.New("Already Known"), true},
+		{errors.New("nonce too low"), false},
+		{nil, false},
+	}
+
+	for _, tc := range testcases {
+		require.Equal(t, tc.expected, IsAlreadyKnown(tc.err), "Wrong result for %v", tc.err)
+	}
+}
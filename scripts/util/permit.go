@@ -0,0 +1,332 @@
+// permit.go contains the signing of EIP-2612 permits, with which a holder
+// approves a spender by an off-chain signature instead of a transaction,
+// and the submission of signed permits by a relayer, which pays the fees.
+package util
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var (
+	// ErrInvalidPermit is returned, when a permit was not signed by its
+	// owner or for another token contract.
+	ErrInvalidPermit = errors.New("invalid permit")
+	// ErrPermitExpired is returned, when the deadline of a permit has passed.
+	ErrPermitExpired = errors.New("permit deadline has passed")
+	// ErrPermitNonce is returned, when the nonce of a permit is not the
+	// current nonce of the owner, e.g. because the permit was already used.
+	ErrPermitNonce = errors.New("permit nonce is not the current nonce of the owner")
+)
+
+// PermitVersion is the version of the EIP-712 domain of the token contract.
+const PermitVersion = "1"
+
+// permitTypes are the EIP-712 types of the domain and of the permit, which
+// are defined in EIP-2612.
+var permitTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Permit": {
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+// Permit is an approval of a spender to transfer up to the value of tokens
+// on behalf of the owner. The nonce is the number of permits, which the
+// owner used before, and the deadline is a unix timestamp.
+type Permit struct {
+	Owner    common.Address `json:"owner"`
+	Spender  common.Address `json:"spender"`
+	Value    *big.Int       `json:"value"`
+	Nonce    *big.Int       `json:"nonce"`
+	Deadline *big.Int       `json:"deadline"`
+}
+
+// SignedPermit is a permit together with the EIP-712 domain, for which it
+// was signed, and the signature of the owner. It is the content of the
+// permit files, which are handed to a relayer.
+type SignedPermit struct {
+	Contract common.Address `json:"contract"`
+	Name     string         `json:"name"`
+	ChainID  *big.Int       `json:"chain_id"`
+	Permit
+	V uint8       `json:"v"`
+	R common.Hash `json:"r"`
+	S common.Hash `json:"s"`
+}
+
+// PermitTypedData returns the EIP-712 typed data of the permit for the token
+// contract with the given name on the chain with the given ID.
+func PermitTypedData(name string, chainID *big.Int, contractAddress common.Address, permit Permit) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       permitTypes,
+		PrimaryType: "Permit",
+		Domain: apitypes.TypedDataDomain{
+			Name:              name,
+			Version:           PermitVersion,
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: contractAddress.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"owner":    permit.Owner.Hex(),
+			"spender":  permit.Spender.Hex(),
+			"value":    (*math.HexOrDecimal256)(permit.Value),
+			"nonce":    (*math.HexOrDecimal256)(permit.Nonce),
+			"deadline": (*math.HexOrDecimal256)(permit.Deadline),
+		},
+	}
+}
+
+// DomainSeparator returns the hash of the EIP-712 domain of the typed data.
+func DomainSeparator(typedData apitypes.TypedData) (common.Hash, error) {
+	separator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(separator), nil
+}
+
+// TypedDataHash returns the EIP-712 hash of the typed data, which is signed.
+func TypedDataHash(typedData apitypes.TypedData) (common.Hash, error) {
+	separator, err := DomainSeparator(typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	structHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash([]byte("\x19\x01"), separator.Bytes(), structHash), nil
+}
+
+// TypedData returns the EIP-712 typed data of the signed permit.
+func (sp *SignedPermit) TypedData() apitypes.TypedData {
+	return PermitTypedData(sp.Name, sp.ChainID, sp.Contract, sp.Permit)
+}
+
+// Signer returns the address, which signed the permit.
+func (sp *SignedPermit) Signer() (common.Address, error) {
+	hash, err := TypedDataHash(sp.TypedData())
+	if err != nil {
+		return common.Address{}, err
+	}
+	if sp.V != 27 && sp.V != 28 {
+		return common.Address{}, fmt.Errorf("%w: v must be 27 or 28, got %d", ErrInvalidPermit, sp.V)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], sp.R.Bytes())
+	copy(sig[32:64], sp.S.Bytes())
+	sig[64] = sp.V - 27
+	pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidPermit, err)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// PermitDeadline returns the deadline, which lies the given duration after
+// the timestamp of the latest block. The block time is used instead of the
+// local clock, because the contract compares the deadline with it.
+func PermitDeadline(ctx context.Context, backend bind.ContractTransactor, validity time.Duration) (*big.Int, error) {
+	header, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetUint64(header.Time + uint64(validity/time.Second)), nil
+}
+
+// SignPermit signs a permit of the owner of the private key, which approves
+// the spender to transfer up to the value of tokens until the deadline. The
+// name of the token, the chain ID and the current nonce of the owner are
+// queried from the backend. The domain is checked against the domain
+// separator of the contract, so that the permit is not signed for a domain,
+// which the contract would reject.
+func SignPermit(ctx context.Context, backend Backend, contractAddress common.Address, privKey *ecdsa.PrivateKey, spender common.Address, value, deadline *big.Int) (*SignedPermit, error) {
+	contract, err := GetContract(backend, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	name, err := contract.Name(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query token name: %w", err)
+	}
+	owner := crypto.PubkeyToAddress(privKey.PublicKey)
+	nonce, err := contract.Nonces(opts, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query permit nonce: %w", err)
+	}
+
+	sp := &SignedPermit{
+		Contract: contractAddress,
+		Name:     name,
+		ChainID:  chainID,
+		Permit: Permit{
+			Owner:    owner,
+			Spender:  spender,
+			Value:    value,
+			Nonce:    nonce,
+			Deadline: deadline,
+		},
+	}
+	typedData := sp.TypedData()
+	separator, err := DomainSeparator(typedData)
+	if err != nil {
+		return nil, err
+	}
+	contractSeparator, err := contract.DOMAINSEPARATOR(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query domain separator: %w", err)
+	}
+	if separator != contractSeparator {
+		return nil, fmt.Errorf("%w: domain separator %s of the contract differs from %s", ErrInvalidPermit, common.Hash(contractSeparator), separator)
+	}
+
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(hash.Bytes(), privKey)
+	if err != nil {
+		return nil, err
+	}
+	sp.R = common.BytesToHash(sig[:32])
+	sp.S = common.BytesToHash(sig[32:64])
+	sp.V = sig[64] + 27
+
+	return sp, nil
+}
+
+// CheckPermit checks the signed permit against the current state of the
+// chain before it is submitted: the chain ID, the signature of the owner,
+// the nonce of the owner and the deadline compared to the latest block.
+func CheckPermit(ctx context.Context, backend Backend, sp *SignedPermit) error {
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	if chainID.Cmp(sp.ChainID) != 0 {
+		return fmt.Errorf("%w: permit was signed for chain ID %v, node reports %v", ErrChainIDMismatch, sp.ChainID, chainID)
+	}
+
+	signer, err := sp.Signer()
+	if err != nil {
+		return err
+	}
+	if signer != sp.Owner {
+		return fmt.Errorf("%w: signed by %s instead of the owner %s", ErrInvalidPermit, signer, sp.Owner)
+	}
+
+	contract, err := GetContract(backend, sp.Contract)
+	if err != nil {
+		return err
+	}
+	nonce, err := contract.Nonces(&bind.CallOpts{Context: ctx}, sp.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to query permit nonce: %w", err)
+	}
+	if nonce.Cmp(sp.Nonce) != 0 {
+		return fmt.Errorf("%w: permit has nonce %v, owner has nonce %v", ErrPermitNonce, sp.Nonce, nonce)
+	}
+
+	header, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if sp.Deadline.Cmp(new(big.Int).SetUint64(header.Time)) < 0 {
+		return fmt.Errorf("%w: deadline %v is before the latest block time %d", ErrPermitExpired, sp.Deadline, header.Time)
+	}
+
+	return nil
+}
+
+// SubmitPermit fills the transaction signer fields using the given fee
+// options and sends a transaction, which submits the signed permit, so that
+// the allowance of the spender is set. The signer pays the fees and does not
+// need to be the owner or the spender. Expired or already used permits are
+// rejected by the contract. The transaction is committed, if the given
+// backend is a simulated backend.
+func SubmitPermit(auth *bind.TransactOpts, backend Backend, sp *SignedPermit, feeOpts FeeOptions) (*types.Transaction, error) {
+	contract, err := GetContract(backend, sp.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	callData, err := GetCallData("permit", sp.Owner, sp.Spender, sp.Value, sp.Deadline, sp.V, [32]byte(sp.R), [32]byte(sp.S))
+	if err != nil {
+		return nil, err
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   &sp.Contract,
+		Data: callData,
+	}
+	auth, err = FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.Permit(auth, sp.Owner, sp.Spender, sp.Value, sp.Deadline, sp.V, sp.R, sp.S)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit transaction on simulated backend
+	Commit(backend)
+
+	return tx, nil
+}
+
+// WritePermit writes the signed permit as indented JSON.
+func WritePermit(w io.Writer, sp *SignedPermit) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(sp)
+}
+
+// ReadPermit reads a signed permit, which was written by WritePermit.
+// Malformed or incomplete permits are rejected with ErrInvalidPermit.
+func ReadPermit(r io.Reader) (*SignedPermit, error) {
+	var sp SignedPermit
+	if err := json.NewDecoder(r).Decode(&sp); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPermit, err)
+	}
+	if sp.ChainID == nil || sp.Value == nil || sp.Nonce == nil || sp.Deadline == nil {
+		return nil, fmt.Errorf("%w: chain_id, value, nonce and deadline are required", ErrInvalidPermit)
+	}
+
+	return &sp, nil
+}
@@ -0,0 +1,154 @@
+// permit_test.go contains the unit tests for signing and submitting EIP-2612
+// permits.
+package util
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPermit tests, that a holder without native tokens can approve a
+// spender with a signed permit, which a relayer submits, and that expired,
+// replayed and modified permits are rejected.
+func TestPermit(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(3)
+	require.NoError(t, err, "Error generating private keys")
+
+	// Only the relayer has funds to pay fees, while the owner holds the
+	// initial supply of the token
+	client := &SimulatedClient{backends.NewSimulatedBackend(core.GenesisAlloc{
+		addresses[0]: {Balance: new(big.Int).Mul(big.NewInt(1000), Ten18)},
+	}, MaxGasPerBlock)}
+	relayer, err := NewTransactionSigner(client, privKeys[0])
+	require.NoError(t, err, "Error creating transaction signer")
+	owner, recipient := addresses[1], addresses[2]
+	settings := DefaultTokenSettings()
+	settings.InitialHolder = owner
+	contractAddress, _, contract, err := DeployContract(relayer, client, settings, DefaultFeeOptions())
+	require.NoError(t, err, "Error deploying contract")
+	ctx := context.Background()
+	amount := Ten18
+
+	deadline, err := PermitDeadline(ctx, client, time.Hour)
+	require.NoError(t, err)
+	permit, err := SignPermit(ctx, client, contractAddress, privKeys[1], relayer.From, amount, deadline)
+	require.NoError(t, err, "Error signing permit")
+	require.Equal(t, owner, permit.Owner)
+	require.Equal(t, settings.Name, permit.Name)
+	require.Zero(t, permit.Nonce.Sign(), "First permit should have nonce 0")
+	signer, err := permit.Signer()
+	require.NoError(t, err)
+	require.Equal(t, owner, signer)
+
+	// The permit file can be read back by the relayer
+	var buf bytes.Buffer
+	require.NoError(t, WritePermit(&buf, permit))
+	written := buf.String()
+	read, err := ReadPermit(&buf)
+	require.NoError(t, err, "Error reading permit")
+	require.NoError(t, WritePermit(&buf, read))
+	require.Equal(t, written, buf.String(), "Permit should be unchanged after reading")
+	require.NoError(t, CheckPermit(ctx, client, read), "Signed permit should be valid")
+
+	// A modified permit is not signed by the owner anymore
+	modified := *read
+	modified.Value = new(big.Int).Mul(amount, big.NewInt(2))
+	require.ErrorIs(t, CheckPermit(ctx, client, &modified), ErrInvalidPermit)
+
+	_, err = SubmitPermit(relayer, client, read, DefaultFeeOptions())
+	require.NoError(t, err, "Error submitting permit")
+	allowance, err := contract.Allowance(nil, owner, relayer.From)
+	require.NoError(t, err)
+	require.Equal(t, amount, allowance, "Permit should set the allowance")
+	nonce, err := contract.Nonces(nil, owner)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), nonce.Int64(), "Permit should increase the nonce")
+
+	_, err = TransferTokensFrom(relayer, client, contractAddress, owner, recipient, amount, DefaultFeeOptions())
+	require.NoError(t, err, "Error transferring permitted tokens")
+	balance, err := contract.BalanceOf(nil, recipient)
+	require.NoError(t, err)
+	require.Equal(t, amount, balance)
+
+	// Replaying the used permit fails the check and is rejected by the
+	// contract, because the nonce was used
+	require.ErrorIs(t, CheckPermit(ctx, client, read), ErrPermitNonce)
+	_, err = SubmitPermit(relayer, client, read, DefaultFeeOptions())
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.ErrorContains(t, err, "ERC20Permit: invalid signature")
+
+	// A permit, whose deadline has passed, is rejected
+	deadline, err = PermitDeadline(ctx, client, time.Minute)
+	require.NoError(t, err)
+	expiring, err := SignPermit(ctx, client, contractAddress, privKeys[1], relayer.From, amount, deadline)
+	require.NoError(t, err, "Error signing permit")
+	require.Equal(t, int64(1), expiring.Nonce.Int64())
+	require.NoError(t, client.AdjustTime(2*time.Minute))
+	client.Commit()
+	require.ErrorIs(t, CheckPermit(ctx, client, expiring), ErrPermitExpired)
+	_, err = SubmitPermit(relayer, client, expiring, DefaultFeeOptions())
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.ErrorContains(t, err, "ERC20Permit: expired deadline")
+}
+
+// TestSignPermitDomain tests, that permits are only signed for the domain,
+// which the contract uses.
+func TestSignPermitDomain(t *testing.T) {
+	privKeys, _, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private key")
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting client and transaction signer")
+	contractAddress, _, contract, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	permit, err := SignPermit(context.Background(), client, contractAddress, privKeys[0], auth.From, Ten18, big.NewInt(1))
+	require.NoError(t, err, "Error signing permit")
+	separator, err := DomainSeparator(permit.TypedData())
+	require.NoError(t, err)
+	contractSeparator, err := contract.DOMAINSEPARATOR(&bind.CallOpts{})
+	require.NoError(t, err)
+	require.Equal(t, common.Hash(contractSeparator), separator, "Domain separator should match the contract")
+
+	permit.ChainID = big.NewInt(1)
+	separator, err = DomainSeparator(permit.TypedData())
+	require.NoError(t, err)
+	require.NotEqual(t, common.Hash(contractSeparator), separator, "Chain ID should be part of the domain")
+	require.ErrorIs(t, CheckPermit(context.Background(), client, permit), ErrChainIDMismatch)
+}
+
+// TestReadPermit tests reading permit files.
+func TestReadPermit(t *testing.T) {
+	testcases := []struct {
+		name   string
+		input  string
+		expErr bool
+	}{
+		{"passes - complete permit", `{"contract": "0x0000000000000000000000000000000000000001", "name": "Maltcoin", "chain_id": 1337, "owner": "0x0000000000000000000000000000000000000002", "spender": "0x0000000000000000000000000000000000000003", "value": 1000000000000000000000, "nonce": 0, "deadline": 100, "v": 27, "r": "0x0000000000000000000000000000000000000000000000000000000000000001", "s": "0x0000000000000000000000000000000000000000000000000000000000000002"}`, false},
+		{"fails - missing deadline", `{"contract": "0x0000000000000000000000000000000000000001", "chain_id": 1337, "value": 1, "nonce": 0}`, true},
+		{"fails - invalid JSON", `{"contract": `, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			permit, err := ReadPermit(strings.NewReader(tc.input))
+			if tc.expErr {
+				require.ErrorIs(t, err, ErrInvalidPermit)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, common.HexToAddress("0x2"), permit.Owner)
+			require.Equal(t, "1000000000000000000000", permit.Value.String())
+			require.Equal(t, uint8(27), permit.V)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// pipeline.go contains a runner for a sequence of steps, e.g. the steps to
+// bootstrap a token deployment, which retries failed steps and reports the
+// outcome of every step.
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Status of a pipeline step
+const (
+	StepSucceeded = "succeeded"
+	StepFailed    = "failed"
+	StepSkipped   = "skipped"
+)
+
+// ErrStepFailed is returned, when a step of a pipeline failed after all
+// attempts.
+var ErrStepFailed = errors.New("step failed")
+
+// Step is a named step of a pipeline.
+type Step struct {
+	Name string
+	// Run executes the step. Errors, which are marked with Permanent, are
+	// not retried.
+	Run func(ctx context.Context) error
+}
+
+// StepResult is the outcome of a step.
+type StepResult struct {
+	Name     string
+	Status   string
+	Attempts int
+	Duration time.Duration
+	Error    string
+}
+
+// RetryOptions define how often and after which delay a failed step is
+// attempted again.
+type RetryOptions struct {
+	// Attempts is the maximum number of attempts of a step including the
+	// first one.
+	Attempts int
+	// Delay is the time between two attempts.
+	Delay time.Duration
+	// OnRetry is called with the error of an attempt before the step is
+	// attempted again.
+	OnRetry func(step string, attempt int, err error)
+}
+
+// DefaultRetryOptions returns the retry options, which are used if not
+// configured otherwise.
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		Attempts: 3,
+		Delay:    2 * time.Second,
+	}
+}
+
+// permanentError marks an error, which is not resolved by retrying.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks the error as permanent, so that the failed step is not
+// retried, e.g. because the input is invalid or a transaction reverted.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// RunSteps runs the steps in the given order and retries failed steps. If a
+// step fails after all attempts, the remaining steps are skipped and the
+// error of the step is returned wrapped in ErrStepFailed. The results of all
+// steps are returned in both cases.
+func RunSteps(ctx context.Context, steps []Step, opts RetryOptions) ([]StepResult, error) {
+	if opts.Attempts < 1 {
+		opts.Attempts = 1
+	}
+
+	results := make([]StepResult, 0, len(steps))
+	var failure error
+	for _, step := range steps {
+		result := StepResult{Name: step.Name, Status: StepSkipped}
+		if failure != nil {
+			results = append(results, result)
+			continue
+		}
+
+		start := time.Now()
+		err := runStep(ctx, step, opts, &result.Attempts)
+		result.Duration = time.Since(start).Round(time.Millisecond)
+		if err != nil {
+			result.Status, result.Error = StepFailed, err.Error()
+			failure = &stepError{step: step.Name, attempts: result.Attempts, err: err}
+		} else {
+			result.Status = StepSucceeded
+		}
+		results = append(results, result)
+	}
+
+	return results, failure
+}
+
+// runStep runs the step until it succeeds, fails permanently or the number
+// of attempts is exhausted.
+func runStep(ctx context.Context, step Step, opts RetryOptions, attempts *int) error {
+	for {
+		*attempts++
+		err := step.Run(ctx)
+		var permanent *permanentError
+		switch {
+		case err == nil:
+			return nil
+		case errors.As(err, &permanent):
+			return err
+		case *attempts >= opts.Attempts:
+			return err
+		}
+
+		if opts.OnRetry != nil {
+			opts.OnRetry(step.Name, *attempts, err)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w (last error: %v)", ctx.Err(), err)
+		case <-time.After(opts.Delay):
+		}
+	}
+}
+
+// stepError is the error of a failed pipeline step.
+type stepError struct {
+	step     string
+	attempts int
+	err      error
+}
+
+func (e *stepError) Error() string {
+	return fmt.Sprintf("%v: %s after %d attempt(s): %v", ErrStepFailed, e.step, e.attempts, e.err)
+}
+
+func (e *stepError) Unwrap() error {
+	return e.err
+}
+
+// Is reports, that the error matches ErrStepFailed.
+func (e *stepError) Is(target error) bool {
+	return target == ErrStepFailed
+}
@@ -0,0 +1,107 @@
+// pipeline_test.go contains the unit tests for running pipeline steps with
+// retries.
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRunSteps tests retrying failed steps and skipping the steps after a
+// failure.
+func TestRunSteps(t *testing.T) {
+	errFlaky := errors.New("node not ready")
+
+	// failing returns a step, which fails the given number of times.
+	failing := func(name string, failures int, err error) Step {
+		attempts := 0
+		return Step{Name: name, Run: func(ctx context.Context) error {
+			attempts++
+			if attempts <= failures {
+				return err
+			}
+			return nil
+		}}
+	}
+
+	testcases := []struct {
+		name        string
+		steps       []Step
+		expErr      error
+		expStatus   []string
+		expAttempts []int
+	}{
+		{
+			"passes - all steps succeed",
+			[]Step{failing("first", 0, nil), failing("second", 0, nil)},
+			nil,
+			[]string{StepSucceeded, StepSucceeded},
+			[]int{1, 1},
+		},
+		{
+			"passes - step succeeds after retries",
+			[]Step{failing("first", 2, errFlaky), failing("second", 0, nil)},
+			nil,
+			[]string{StepSucceeded, StepSucceeded},
+			[]int{3, 1},
+		},
+		{
+			"fails - attempts exhausted",
+			[]Step{failing("first", 3, errFlaky), failing("second", 0, nil)},
+			errFlaky,
+			[]string{StepFailed, StepSkipped},
+			[]int{3, 0},
+		},
+		{
+			"fails - permanent error is not retried",
+			[]Step{failing("first", 0, nil), failing("second", 1, Permanent(errFlaky)), failing("third", 0, nil)},
+			errFlaky,
+			[]string{StepSucceeded, StepFailed, StepSkipped},
+			[]int{1, 1, 0},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var retries int
+			opts := RetryOptions{Attempts: 3, Delay: time.Millisecond, OnRetry: func(string, int, error) { retries++ }}
+			results, err := RunSteps(context.Background(), tc.steps, opts)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, ErrStepFailed)
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, results, len(tc.steps))
+			total := 0
+			for i, result := range results {
+				require.Equal(t, tc.steps[i].Name, result.Name)
+				require.Equal(t, tc.expStatus[i], result.Status, "Unexpected status of %s", result.Name)
+				require.Equal(t, tc.expAttempts[i], result.Attempts, "Unexpected attempts of %s", result.Name)
+				require.Equal(t, result.Status == StepFailed, result.Error != "", "Only failed steps should have an error")
+				if result.Attempts > 0 {
+					total += result.Attempts - 1
+				}
+			}
+			require.Equal(t, total, retries, "Every retry should be reported")
+		})
+	}
+}
+
+// TestRunStepsCanceled tests, that a canceled context stops the retries.
+func TestRunStepsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	steps := []Step{{Name: "cancel", Run: func(context.Context) error {
+		cancel()
+		return errors.New("node not ready")
+	}}}
+
+	results, err := RunSteps(ctx, steps, RetryOptions{Attempts: 5, Delay: time.Hour})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, results[0].Attempts)
+}
@@ -0,0 +1,296 @@
+// revert.go contains the diagnosis of failed contract calls and
+// transactions. The revert data returned by the EVM is decoded into typed
+// errors for Error(string) reasons, Panic(uint256) codes and the custom
+// errors of a contract ABI.
+package util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrExecutionReverted is wrapped by all errors, which describe a reverted
+// contract execution.
+var ErrExecutionReverted = errors.New("execution reverted")
+
+var (
+	// errorSelector is the selector of Error(string) reasons.
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+	// panicSelector is the selector of Panic(uint256) codes.
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// panicReasons describes the panic codes of the Solidity compiler.
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to uninitialized function",
+}
+
+// RevertError is the reason of a revert, which was given as Error(string),
+// e.g. by require statements.
+type RevertError struct {
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrExecutionReverted, e.Reason)
+}
+
+func (e *RevertError) Unwrap() error {
+	return ErrExecutionReverted
+}
+
+// PanicError is the code of a Panic(uint256) revert, which is raised by
+// failed assertions or arithmetic errors.
+type PanicError struct {
+	Code *big.Int
+}
+
+func (e *PanicError) Error() string {
+	reason := "unknown panic code"
+	if e.Code.IsUint64() {
+		if description, ok := panicReasons[e.Code.Uint64()]; ok {
+			reason = description
+		}
+	}
+
+	return fmt.Sprintf("%v: panic 0x%x (%s)", ErrExecutionReverted, e.Code, reason)
+}
+
+func (e *PanicError) Unwrap() error {
+	return ErrExecutionReverted
+}
+
+// CustomError is a revert with a custom error, which is defined in the
+// contract ABI.
+type CustomError struct {
+	Definition abi.Error
+	Args       []interface{}
+}
+
+func (e *CustomError) Error() string {
+	args := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		args[i] = fmt.Sprintf("%s: %s", e.Definition.Inputs[i].Name, FormatValue(arg))
+	}
+
+	return fmt.Sprintf("%v: %s(%s)", ErrExecutionReverted, e.Definition.Name, strings.Join(args, ", "))
+}
+
+func (e *CustomError) Unwrap() error {
+	return ErrExecutionReverted
+}
+
+// UnknownRevertError is a revert without data or with data, which does not
+// match any known error.
+type UnknownRevertError struct {
+	Data []byte
+}
+
+func (e *UnknownRevertError) Error() string {
+	if len(e.Data) == 0 {
+		return fmt.Sprintf("%v without reason", ErrExecutionReverted)
+	}
+
+	return fmt.Sprintf("%v with unknown data %s", ErrExecutionReverted, hexutil.Encode(e.Data))
+}
+
+func (e *UnknownRevertError) Unwrap() error {
+	return ErrExecutionReverted
+}
+
+// FailedTransactionError describes why a transaction, which was included in
+// a block, failed. It matches ErrTransactionFailed with errors.Is and
+// unwraps to the reason.
+type FailedTransactionError struct {
+	TxHash      common.Hash
+	BlockNumber *big.Int
+	Reason      error
+}
+
+func (e *FailedTransactionError) Error() string {
+	return fmt.Sprintf("transaction %s failed in block %v: %v", e.TxHash.Hex(), e.BlockNumber, e.Reason)
+}
+
+func (e *FailedTransactionError) Unwrap() error {
+	return e.Reason
+}
+
+// Is reports, that the error matches ErrTransactionFailed.
+func (e *FailedTransactionError) Is(target error) bool {
+	return target == ErrTransactionFailed
+}
+
+// DecodeRevert decodes the revert data of a contract execution into a
+// RevertError, PanicError or a CustomError of one of the given ABIs. Data,
+// which can't be decoded, is returned as UnknownRevertError.
+func DecodeRevert(data []byte, abis ...*abi.ABI) error {
+	if len(data) < 4 {
+		return &UnknownRevertError{Data: data}
+	}
+
+	switch selector := data[:4]; {
+	case bytes.Equal(selector, errorSelector):
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			return &RevertError{Reason: reason}
+		}
+	case bytes.Equal(selector, panicSelector):
+		if len(data) == 4+common.HashLength {
+			return &PanicError{Code: new(big.Int).SetBytes(data[4:])}
+		}
+	default:
+		for _, contractABI := range abis {
+			for _, definition := range contractABI.Errors {
+				if !bytes.Equal(selector, definition.ID[:4]) {
+					continue
+				}
+				if args, err := definition.Inputs.Unpack(data[4:]); err == nil {
+					return &CustomError{Definition: definition, Args: args}
+				}
+			}
+		}
+	}
+
+	return &UnknownRevertError{Data: data}
+}
+
+// RevertData returns the revert data, which is attached to the given error
+// by the RPC client or the simulated backend.
+func RevertData(err error) ([]byte, bool) {
+	var dataErr interface{ ErrorData() interface{} }
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+// DecodeError replaces an error, which carries revert data, with the
+// decoded revert. Other errors are returned unchanged.
+func DecodeError(err error, abis ...*abi.ABI) error {
+	if err == nil || errors.Is(err, ErrExecutionReverted) {
+		return err
+	}
+	if data, ok := RevertData(err); ok {
+		return DecodeRevert(data, abis...)
+	}
+
+	return err
+}
+
+// DiagnoseCall decodes the error of a failed call or gas estimation of the
+// given message. If the error does not carry revert data, e.g. because it
+// was wrapped or the node omits it, the message is executed again with
+// eth_call at the given block to retrieve the revert data. The original
+// error is returned, if no revert can be determined.
+func DiagnoseCall(ctx context.Context, caller bind.ContractCaller, msg ethereum.CallMsg, blockNumber *big.Int, callErr error, abis ...*abi.ABI) error {
+	if decoded := DecodeError(callErr, abis...); errors.Is(decoded, ErrExecutionReverted) {
+		return decoded
+	}
+
+	// Fees are not needed to reproduce the revert and could make the call
+	// fail for other reasons.
+	msg.GasPrice, msg.GasFeeCap, msg.GasTipCap = nil, nil, nil
+	_, err := caller.CallContract(ctx, msg, blockNumber)
+	if decoded := DecodeError(err, abis...); errors.Is(decoded, ErrExecutionReverted) {
+		return decoded
+	}
+
+	return callErr
+}
+
+// maltcoinABIs returns the Maltcoin ABI to decode its errors or no ABI, if
+// it can't be parsed.
+func maltcoinABIs() []*abi.ABI {
+	maltcoinABI, err := GetMaltcoinABI()
+	if err != nil {
+		return nil
+	}
+
+	return []*abi.ABI{maltcoinABI}
+}
+
+// DiagnoseBackend defines the methods, which are needed to diagnose failed
+// transactions.
+type DiagnoseBackend interface {
+	bind.ContractCaller
+	ReceiptBackend
+
+	TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error)
+}
+
+// DiagnoseTransaction determines why the transaction with the given hash
+// failed. The transaction is replayed with eth_call at the block of its
+// receipt and the revert data is decoded with the given ABIs. The returned
+// FailedTransactionError reports transactions, which ran out of gas, or
+// failed for an unknown reason, too. If the transaction succeeded, nil is
+// returned.
+func DiagnoseTransaction(ctx context.Context, backend DiagnoseBackend, txHash common.Hash, abis ...*abi.ABI) error {
+	receipt, err := backend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return err
+	}
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return nil
+	}
+	tx, _, err := backend.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return err
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return err
+	}
+
+	failure := &FailedTransactionError{TxHash: txHash, BlockNumber: receipt.BlockNumber}
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	_, err = backend.CallContract(ctx, msg, receipt.BlockNumber)
+	switch decoded := DecodeError(err, abis...); {
+	case errors.Is(decoded, ErrExecutionReverted):
+		failure.Reason = decoded
+	case receipt.GasUsed >= tx.Gas():
+		failure.Reason = fmt.Errorf("out of gas (gas limit %d)", tx.Gas())
+	case err != nil:
+		failure.Reason = fmt.Errorf("unknown reason, replaying the transaction failed: %w", err)
+	default:
+		failure.Reason = errors.New("unknown reason, replaying the transaction succeeded")
+	}
+
+	return failure
+}
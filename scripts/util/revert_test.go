@@ -0,0 +1,170 @@
+// revert_test.go contains the unit tests for the diagnosis of failed calls
+// and transactions.
+package util
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// erc20ErrorsABI contains a custom error of the OpenZeppelin ERC20 contracts.
+const erc20ErrorsABI = `[{"type":"error","name":"ERC20InsufficientBalance","inputs":[
+	{"name":"sender","type":"address"},
+	{"name":"balance","type":"uint256"},
+	{"name":"needed","type":"uint256"}]}]`
+
+// packRevert encodes revert data with the given error signature and
+// arguments.
+func packRevert(t *testing.T, signature string, types []string, args ...interface{}) []byte {
+	arguments := make(abi.Arguments, len(types))
+	for i, typ := range types {
+		arguments[i] = abi.Argument{Type: mustNewType(t, typ)}
+	}
+	packed, err := arguments.Pack(args...)
+	require.NoError(t, err, "Error packing revert data")
+
+	return append(crypto.Keccak256([]byte(signature))[:4], packed...)
+}
+
+// TestDecodeRevert tests decoding the different kinds of revert data.
+func TestDecodeRevert(t *testing.T) {
+	errorsABI, err := abi.JSON(strings.NewReader(erc20ErrorsABI))
+	require.NoError(t, err, "Error parsing ABI")
+	sender := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	testcases := []struct {
+		name   string
+		data   []byte
+		expErr string
+	}{
+		{
+			"reason string",
+			packRevert(t, "Error(string)", []string{"string"}, "ERC20: transfer amount exceeds balance"),
+			"execution reverted: ERC20: transfer amount exceeds balance",
+		},
+		{
+			"panic code",
+			packRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x11)),
+			"execution reverted: panic 0x11 (arithmetic overflow or underflow)",
+		},
+		{
+			"unknown panic code",
+			packRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x99)),
+			"execution reverted: panic 0x99 (unknown panic code)",
+		},
+		{
+			"custom error",
+			packRevert(t, "ERC20InsufficientBalance(address,uint256,uint256)", []string{"address", "uint256", "uint256"}, sender, big.NewInt(1), big.NewInt(2)),
+			"execution reverted: ERC20InsufficientBalance(sender: 0x1234567890123456789012345678901234567890, balance: 1, needed: 2)",
+		},
+		{
+			"unknown custom error",
+			[]byte{1, 2, 3, 4},
+			"execution reverted with unknown data 0x01020304",
+		},
+		{
+			"truncated reason string",
+			packRevert(t, "Error(string)", []string{"string"}, "reason")[:10],
+			"execution reverted with unknown data",
+		},
+		{
+			"no data",
+			nil,
+			"execution reverted without reason",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := DecodeRevert(tc.data, &errorsABI)
+			require.ErrorIs(t, err, ErrExecutionReverted, "Revert should match ErrExecutionReverted")
+			require.Contains(t, err.Error(), tc.expErr, "Wrong error message")
+		})
+	}
+}
+
+// TestDiagnoseCall tests, that failed gas estimations return the decoded
+// revert reason.
+func TestDiagnoseCall(t *testing.T) {
+	privKeys, addresses, err := DerivePrivKeysAndAddresses(TestMnemonic, "", 2)
+	require.NoError(t, err, "Error deriving accounts")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client")
+	contractAddress, _, _, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	tooMuch := new(big.Int).Mul(big.NewInt(20000), Ten18)
+	_, err = TransferTokens(auth, client, contractAddress, addresses[1], tooMuch, DefaultFeeOptions())
+	var revertErr *RevertError
+	require.True(t, errors.As(err, &revertErr), "Expected a revert error, got %v", err)
+	require.Equal(t, "ERC20: transfer amount exceeds balance", revertErr.Reason, "Wrong revert reason")
+
+	// The binding of a generic call wraps the error of the gas estimation
+	contractABI, err := GetMaltcoinABI()
+	require.NoError(t, err, "Error getting ABI")
+	signer, err := NewTransactionSigner(client, privKeys[0])
+	require.NoError(t, err, "Error creating transaction signer")
+	_, err = TransactMethod(signer, client, contractABI, contractAddress, "transfer", []interface{}{addresses[1], tooMuch})
+	require.True(t, errors.As(err, &revertErr), "Expected a revert error, got %v", err)
+	require.Equal(t, "ERC20: transfer amount exceeds balance", revertErr.Reason, "Wrong revert reason")
+}
+
+// TestDiagnoseTransaction tests determining the reason of transactions,
+// which were included in a block, but failed.
+func TestDiagnoseTransaction(t *testing.T) {
+	privKeys, addresses, err := DerivePrivKeysAndAddresses(TestMnemonic, "", 2)
+	require.NoError(t, err, "Error deriving accounts")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client")
+	_, _, contract, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+	contractABI, err := GetMaltcoinABI()
+	require.NoError(t, err, "Error getting ABI")
+
+	testcases := []struct {
+		name      string
+		amount    *big.Int
+		gasLimit  uint64
+		expErr    bool
+		expReason string
+	}{
+		{"success", big.NewInt(1), 100000, false, ""},
+		{"revert", new(big.Int).Mul(big.NewInt(20000), Ten18), 100000, true, "execution reverted: ERC20: transfer amount exceeds balance"},
+		{"out of gas", big.NewInt(1), 25000, true, "out of gas (gas limit 25000)"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			// A fixed gas limit skips the gas estimation, so that failing
+			// transactions are included in a block.
+			signer, err := NewTransactionSigner(client, privKeys[0])
+			require.NoError(t, err, "Error creating transaction signer")
+			signer.GasLimit = tc.gasLimit
+			tx, err := contract.Transfer(signer, addresses[1], tc.amount)
+			require.NoError(t, err, "Error sending transaction")
+			client.Commit()
+
+			err = DiagnoseTransaction(context.Background(), client, tx.Hash(), contractABI)
+			if !tc.expErr {
+				require.NoError(t, err, "Successful transaction should not be diagnosed")
+				return
+			}
+
+			var failure *FailedTransactionError
+			require.True(t, errors.As(err, &failure), "Expected a failed transaction error, got %v", err)
+			require.ErrorIs(t, err, ErrTransactionFailed, "Failure should match ErrTransactionFailed")
+			require.Equal(t, tx.Hash(), failure.TxHash, "Wrong transaction hash")
+			require.Equal(t, tc.expReason, failure.Reason.Error(), "Wrong failure reason")
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// snapshot.go contains the snapshot of the token balances of all holders at
+// a given block and its export to CSV and JSON.
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrSnapshotMismatch is returned, when the sum of the balances in a
+// snapshot does not equal the total supply at the snapshot block.
+var ErrSnapshotMismatch = errors.New("sum of balances does not match total supply")
+
+// SnapshotBackend defines the methods, which are needed to find the holders
+// of a token and to query their balances at a past block.
+type SnapshotBackend interface {
+	bind.ContractCaller
+	bind.ContractFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// SnapshotOptions configure which blocks are scanned for holders.
+type SnapshotOptions struct {
+	// StartBlock is the first block, which is scanned for transfers, e.g.
+	// the deployment block of the contract.
+	StartBlock uint64
+	// BatchSize is the maximum number of blocks, which are filtered with
+	// one query.
+	BatchSize uint64
+	// OnProgress is called after each scanned batch with the last scanned
+	// block and the snapshot block.
+	OnProgress func(block, target uint64)
+}
+
+// Holder is an address with its token balance.
+type Holder struct {
+	Address common.Address `json:"address"`
+	Balance *big.Int       `json:"balance"`
+}
+
+// Snapshot contains the balances of all holders of a token at a block.
+// Addresses, which held tokens before but not at the snapshot block, are
+// omitted. The balances are given in the smallest unit of the token.
+type Snapshot struct {
+	Contract    common.Address `json:"contract"`
+	Symbol      string         `json:"symbol"`
+	Decimals    uint8          `json:"decimals"`
+	BlockNumber uint64         `json:"block_number"`
+	TotalSupply *big.Int       `json:"total_supply"`
+	Holders     []Holder       `json:"holders"`
+}
+
+// Sum returns the sum of the balances of all holders.
+func (s *Snapshot) Sum() *big.Int {
+	sum := new(big.Int)
+	for _, holder := range s.Holders {
+		sum.Add(sum, holder.Balance)
+	}
+
+	return sum
+}
+
+// TakeSnapshot finds every address, which received tokens up to the given
+// block, by scanning the Transfer logs of the contract and queries its
+// balance at that block. The holders are sorted by address. If the sum of
+// the balances does not equal the total supply at the block, the snapshot
+// is returned together with ErrSnapshotMismatch.
+func TakeSnapshot(ctx context.Context, backend SnapshotBackend, contractAddress common.Address, blockNumber uint64, opts SnapshotOptions) (*Snapshot, error) {
+	if opts.BatchSize == 0 {
+		opts.BatchSize = DefaultIndexerOptions().BatchSize
+	}
+	if opts.StartBlock > blockNumber {
+		return nil, fmt.Errorf("start block %d is after snapshot block %d", opts.StartBlock, blockNumber)
+	}
+	header, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	if head := header.Number.Uint64(); blockNumber > head {
+		return nil, fmt.Errorf("snapshot block %d is after the latest block %d", blockNumber, head)
+	}
+
+	caller, err := maltcoin.NewMaltcoinCaller(contractAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := maltcoin.NewMaltcoinFilterer(contractAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every holder has received tokens, so the recipients of all transfers
+	// contain all holders.
+	recipients := make(map[common.Address]struct{})
+	for from := opts.StartBlock; from <= blockNumber; from += opts.BatchSize {
+		to := from + opts.BatchSize - 1
+		if to > blockNumber || to < from {
+			to = blockNumber
+		}
+
+		transfers, err := filterer.FilterTransfer(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter transfers in blocks %d to %d: %w", from, to, err)
+		}
+		for transfers.Next() {
+			if (transfers.Event.To != common.Address{}) {
+				recipients[transfers.Event.To] = struct{}{}
+			}
+		}
+		err = transfers.Error()
+		transfers.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter transfers in blocks %d to %d: %w", from, to, err)
+		}
+
+		if opts.OnProgress != nil {
+			opts.OnProgress(to, blockNumber)
+		}
+		if to == blockNumber {
+			break
+		}
+	}
+
+	callOpts := &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(blockNumber), Context: ctx}
+	unit, err := GetTokenUnit(callOpts, backend, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{
+		Contract:    contractAddress,
+		Symbol:      unit.Symbol,
+		Decimals:    unit.Decimals,
+		BlockNumber: blockNumber,
+		Holders:     []Holder{},
+	}
+	if snapshot.TotalSupply, err = caller.TotalSupply(callOpts); err != nil {
+		return nil, fmt.Errorf("failed to retrieve total supply at block %d: %w", blockNumber, err)
+	}
+	for recipient := range recipients {
+		balance, err := caller.BalanceOf(callOpts, recipient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve balance of %s at block %d: %w", recipient, blockNumber, err)
+		}
+		if balance.Sign() > 0 {
+			snapshot.Holders = append(snapshot.Holders, Holder{Address: recipient, Balance: balance})
+		}
+	}
+	sort.Slice(snapshot.Holders, func(i, j int) bool {
+		return bytes.Compare(snapshot.Holders[i].Address.Bytes(), snapshot.Holders[j].Address.Bytes()) < 0
+	})
+
+	if sum := snapshot.Sum(); sum.Cmp(snapshot.TotalSupply) != 0 {
+		return snapshot, fmt.Errorf("%w: %v != %v at block %d", ErrSnapshotMismatch, sum, snapshot.TotalSupply, blockNumber)
+	}
+
+	return snapshot, nil
+}
+
+// Unit returns the unit of the token, either in whole tokens or, if raw is
+// set, in the smallest unit.
+func (s *Snapshot) Unit(raw bool) TokenUnit {
+	return TokenUnit{Symbol: s.Symbol, Decimals: s.Decimals, Raw: raw}
+}
+
+// WriteSnapshotCSV writes the holders of the snapshot as address,balance
+// rows with a header row. The balances are given in whole tokens or, if raw
+// is set, in the smallest unit, so that the file can be used as input of a
+// batch transfer in the same unit.
+func WriteSnapshotCSV(w io.Writer, snapshot *Snapshot, raw bool) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"address", "balance"}); err != nil {
+		return err
+	}
+	for _, holder := range snapshot.Holders {
+		balance := NewTokenAmount(holder.Balance, snapshot.Unit(raw))
+		if err := writer.Write([]string{holder.Address.Hex(), balance.Plain()}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// WriteSnapshotJSON writes the snapshot including the block and the total
+// supply as indented JSON.
+func WriteSnapshotJSON(w io.Writer, snapshot *Snapshot) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(snapshot)
+}
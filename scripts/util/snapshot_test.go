@@ -0,0 +1,95 @@
+// snapshot_test.go contains the unit tests for the snapshots of token
+// balances.
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTakeSnapshot tests finding all holders and exporting their balances.
+func TestTakeSnapshot(t *testing.T) {
+	privKeys, addresses, err := DerivePrivKeysAndAddresses(TestMnemonic, "", 3)
+	require.NoError(t, err, "Error deriving accounts")
+
+	// Both the deployer and the second recipient need funds to pay fees
+	funds := new(big.Int).Mul(big.NewInt(1000), Ten18)
+	client := &SimulatedClient{backends.NewSimulatedBackend(core.GenesisAlloc{
+		addresses[0]: {Balance: funds},
+		addresses[2]: {Balance: funds},
+	}, MaxGasPerBlock)}
+	auth, err := NewTransactionSigner(client, privKeys[0])
+	require.NoError(t, err, "Error creating transaction signer")
+	contractAddress, _, contract, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+	totalSupply, err := contract.TotalSupply(nil)
+	require.NoError(t, err, "Error getting total supply")
+
+	_, err = TransferTokens(auth, client, contractAddress, addresses[1], big.NewInt(100), DefaultFeeOptions())
+	require.NoError(t, err, "Error transferring tokens")
+	_, err = TransferTokens(auth, client, contractAddress, addresses[2], big.NewInt(30), DefaultFeeOptions())
+	require.NoError(t, err, "Error transferring tokens")
+
+	// The second recipient sends all tokens back and is no holder anymore
+	auth2, err := NewTransactionSigner(client, privKeys[2])
+	require.NoError(t, err, "Error creating transaction signer")
+	_, err = TransferTokens(auth2, client, contractAddress, addresses[0], big.NewInt(30), DefaultFeeOptions())
+	require.NoError(t, err, "Error transferring tokens")
+
+	head := client.Blockchain().CurrentBlock().NumberU64()
+	var batches int
+	snapshot, err := TakeSnapshot(context.Background(), client, contractAddress, head, SnapshotOptions{
+		BatchSize:  2,
+		OnProgress: func(_, _ uint64) { batches++ },
+	})
+	require.NoError(t, err, "Error taking snapshot")
+	require.Equal(t, int(head/2+1), batches, "Wrong number of batches")
+	require.Equal(t, head, snapshot.BlockNumber, "Wrong block number")
+	require.Equal(t, totalSupply, snapshot.TotalSupply, "Wrong total supply")
+	require.Equal(t, TokenUnit{Symbol: "MALT", Decimals: 18}, snapshot.Unit(false), "Wrong token unit")
+	require.Equal(t, totalSupply, snapshot.Sum(), "Balances should sum up to the total supply")
+
+	expected := map[string]*big.Int{
+		addresses[0].Hex(): new(big.Int).Sub(totalSupply, big.NewInt(100)),
+		addresses[1].Hex(): big.NewInt(100),
+	}
+	require.Len(t, snapshot.Holders, len(expected), "Wrong number of holders")
+	for _, holder := range snapshot.Holders {
+		require.Equal(t, expected[holder.Address.Hex()], holder.Balance, "Wrong balance of %s", holder.Address)
+	}
+
+	// The CSV export can be read as batch of transfers
+	var csvOut bytes.Buffer
+	require.NoError(t, WriteSnapshotCSV(&csvOut, snapshot, true), "Error writing CSV")
+	require.Contains(t, csvOut.String(), fmt.Sprintf("%s,100\n", addresses[1].Hex()), "Missing holder row")
+	transfers, err := ReadBatchTransfers(&csvOut, "evmos", snapshot.Unit(true))
+	require.NoError(t, err, "CSV export should be a valid batch file")
+	require.Len(t, transfers, 2, "Wrong number of rows")
+
+	// Balances in whole tokens are read with the same unit
+	csvOut.Reset()
+	require.NoError(t, WriteSnapshotCSV(&csvOut, snapshot, false), "Error writing CSV")
+	require.Contains(t, csvOut.String(), fmt.Sprintf("%s,0.0000000000000001\n", addresses[1].Hex()), "Missing holder row")
+	transfers, err = ReadBatchTransfers(&csvOut, "evmos", snapshot.Unit(false))
+	require.NoError(t, err, "CSV export should be a valid batch file")
+	for _, transfer := range transfers {
+		require.Equal(t, expected[transfer.Recipient.Hex()], transfer.Amount, "Wrong amount of %s", transfer.Recipient)
+	}
+
+	var jsonOut bytes.Buffer
+	require.NoError(t, WriteSnapshotJSON(&jsonOut, snapshot), "Error writing JSON")
+	var decoded Snapshot
+	require.NoError(t, json.Unmarshal(jsonOut.Bytes(), &decoded), "Error decoding JSON")
+	require.Equal(t, *snapshot, decoded, "JSON export should contain the snapshot")
+
+	_, err = TakeSnapshot(context.Background(), client, contractAddress, head+1, SnapshotOptions{})
+	require.Error(t, err, "Snapshot of a future block should fail")
+}
@@ -0,0 +1,69 @@
+// token.go contains the settings of a Maltcoin token contract, which are
+// passed to its constructor upon deployment.
+package util
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TokenSettings contains the constructor arguments of the Maltcoin token
+// contract.
+type TokenSettings struct {
+	Name   string
+	Symbol string
+	// InitialSupply is the amount of tokens in the smallest unit, which is
+	// minted upon deployment.
+	InitialSupply *big.Int
+	// InitialHolder receives the initial supply. If it is the zero address,
+	// the supply is minted to the deployer.
+	InitialHolder common.Address
+}
+
+// DefaultTokenSettings returns the settings of the original Maltcoin token,
+// i.e. 10000 MALT, which are minted to the deployer.
+func DefaultTokenSettings() TokenSettings {
+	return TokenSettings{
+		Name:          "Maltcoin",
+		Symbol:        "MALT",
+		InitialSupply: new(big.Int).Mul(big.NewInt(10000), Ten18),
+	}
+}
+
+// Validate checks, that the name and symbol are set and that the initial
+// supply is not negative.
+func (s TokenSettings) Validate() error {
+	switch {
+	case s.Name == "":
+		return errors.New("token name must not be empty")
+	case s.Symbol == "":
+		return errors.New("token symbol must not be empty")
+	case s.InitialSupply == nil || s.InitialSupply.Sign() < 0:
+		return errors.New("initial supply must not be negative")
+	}
+
+	return nil
+}
+
+// Args returns the constructor arguments in the order of the constructor
+// parameters.
+func (s TokenSettings) Args() []interface{} {
+	return []interface{}{s.Name, s.Symbol, s.InitialSupply, s.InitialHolder}
+}
+
+// Holder returns the account, which receives the initial supply, if the
+// contract is deployed by the given deployer.
+func (s TokenSettings) Holder(deployer common.Address) common.Address {
+	if s.InitialHolder == (common.Address{}) {
+		return deployer
+	}
+
+	return s.InitialHolder
+}
+
+// Unit returns the unit of the token, which has 18 decimals.
+func (s TokenSettings) Unit() TokenUnit {
+	return TokenUnit{Symbol: s.Symbol, Decimals: 18}
+}
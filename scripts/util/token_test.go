@@ -0,0 +1,68 @@
+// token_test.go contains the unit tests for the settings of deployed token
+// contracts.
+package util
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeployWithTokenSettings tests deploying token contracts with different
+// constructor arguments on a simulated backend.
+func TestDeployWithTokenSettings(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+
+	supply := new(big.Int).Mul(big.NewInt(5), Ten18)
+
+	testcases := []struct {
+		name     string
+		settings TokenSettings
+		expErr   string
+	}{
+		{"passes - default settings", DefaultTokenSettings(), ""},
+		{"passes - other token minted to deployer", TokenSettings{Name: "Staging Coin", Symbol: "STG", InitialSupply: supply}, ""},
+		{"passes - minted to other holder", TokenSettings{Name: "Maltcoin", Symbol: "MALT", InitialSupply: supply, InitialHolder: addresses[1]}, ""},
+		{"passes - no initial supply", TokenSettings{Name: "Maltcoin", Symbol: "MALT", InitialSupply: new(big.Int)}, ""},
+		{"fails - empty name", TokenSettings{Symbol: "MALT", InitialSupply: supply}, "token name must not be empty"},
+		{"fails - empty symbol", TokenSettings{Name: "Maltcoin", InitialSupply: supply}, "token symbol must not be empty"},
+		{"fails - missing supply", TokenSettings{Name: "Maltcoin", Symbol: "MALT"}, "initial supply must not be negative"},
+		{"fails - negative supply", TokenSettings{Name: "Maltcoin", Symbol: "MALT", InitialSupply: big.NewInt(-1)}, "initial supply must not be negative"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+			require.NoError(t, err, "Error getting client and transaction signer")
+
+			_, _, contract, err := DeployContract(auth, client, tc.settings, DefaultFeeOptions())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err, "Could not deploy contract")
+
+			name, err := contract.Name(nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.settings.Name, name, "Wrong token name")
+			symbol, err := contract.Symbol(nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.settings.Symbol, symbol, "Wrong token symbol")
+			totalSupply, err := contract.TotalSupply(nil)
+			require.NoError(t, err)
+			require.Zero(t, tc.settings.InitialSupply.Cmp(totalSupply), "Wrong total supply")
+
+			holder := tc.settings.Holder(auth.From)
+			balance, err := contract.BalanceOf(nil, holder)
+			require.NoError(t, err)
+			require.Zero(t, tc.settings.InitialSupply.Cmp(balance), "Initial holder should hold the supply")
+			if holder != auth.From {
+				balance, err = contract.BalanceOf(nil, auth.From)
+				require.NoError(t, err)
+				require.Zero(t, balance.Sign(), "Deployer should not hold tokens")
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package util
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -21,8 +22,9 @@ import (
 )
 
 var (
-	// Evmos serves on port 8545 out of the box
-	blockchainURL = "http://localhost:8545"
+	// ErrNoContractCode is returned, when there is no contract code
+	// stored at a given address.
+	ErrNoContractCode = errors.New("no contract code at address")
 
 	// Defines the amount of tokens initially deployed to a contract
 	// on the simulated backend
@@ -47,45 +49,157 @@ type ReceiptBackend interface {
 }
 
 // DeployContractAndCommit deploys an instance of the ERC20 token contract
-// and commits the transaction to the simulated backend.
-// The function returns the contract address, the transaction, and an
-// instance of the contract binding.
-func DeployContractAndCommit(auth *bind.TransactOpts, client *backends.SimulatedBackend) (common.Address, *types.Transaction, *maltcoin.Maltcoin, error) {
+// with the given settings and commits the transaction, if the given backend
+// is a simulated backend. The function returns the contract address, the
+// transaction, and an instance of the contract binding.
+func DeployContractAndCommit(auth *bind.TransactOpts, backend Backend, settings TokenSettings) (common.Address, *types.Transaction, *maltcoin.Maltcoin, error) {
+	if err := settings.Validate(); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
 	// Deploy contract
-	contractAddress, tx, contract, err := maltcoin.DeployMaltcoin(auth, client)
+	contractAddress, tx, contract, err := maltcoin.DeployMaltcoin(auth, backend, settings.Name, settings.Symbol, settings.InitialSupply, settings.InitialHolder)
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
 
 	// Commit transaction on simulated backend
-	client.Commit()
+	Commit(backend)
 
 	return contractAddress, tx, contract, nil
 }
 
-// FillTransactionSignerFields takes the transaction signer, the client
+// DeployContract fills the transaction signer fields for the deployment of
+// the ERC20 token contract with the given settings using the given fee
+// options and deploys it. The function returns the contract address, the
+// transaction, and an instance of the contract binding.
+func DeployContract(auth *bind.TransactOpts, backend Backend, settings TokenSettings, feeOpts FeeOptions) (common.Address, *types.Transaction, *maltcoin.Maltcoin, error) {
+	if err := settings.Validate(); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	// Define the ethereum call message, which contains the deployment
+	// bytecode to estimate the gas consumption.
+	deployData, err := GetDeployData(settings.Args()...)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   nil,
+		Data: deployData,
+	}
+
+	// Fill transaction signer fields for this specific transaction
+	auth, err = FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	return DeployContractAndCommit(auth, backend, settings)
+}
+
+// TransferTokens fills the transaction signer fields using the given fee
+// options and sends a transaction, which transfers the amount of tokens to
+// the recipient. The transaction is committed, if the given backend is a
+// simulated backend.
+func TransferTokens(auth *bind.TransactOpts, backend Backend, contractAddress, recipient common.Address, amount *big.Int, feeOpts FeeOptions) (*types.Transaction, error) {
+	contract, err := GetContract(backend, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the call data to estimate the gas consumption
+	callData, err := GetCallData("transfer", recipient, amount)
+	if err != nil {
+		return nil, err
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   &contractAddress,
+		Data: callData,
+	}
+	auth, err = FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.Transfer(auth, recipient, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit transaction on simulated backend
+	Commit(backend)
+
+	return tx, nil
+}
+
+// TransferTokensFrom fills the transaction signer fields using the given fee
+// options and sends a transaction, which transfers the amount of tokens from
+// the owner, who approved the signer to spend them, to the recipient. The
+// transaction is committed, if the given backend is a simulated backend.
+func TransferTokensFrom(auth *bind.TransactOpts, backend Backend, contractAddress, owner, recipient common.Address, amount *big.Int, feeOpts FeeOptions) (*types.Transaction, error) {
+	contract, err := GetContract(backend, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	callData, err := GetCallData("transferFrom", owner, recipient, amount)
+	if err != nil {
+		return nil, err
+	}
+	callMsg := ethereum.CallMsg{
+		From: auth.From,
+		To:   &contractAddress,
+		Data: callData,
+	}
+	auth, err = FillTransactionSignerFieldsWithFees(auth, backend, callMsg, feeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.TransferFrom(auth, owner, recipient, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit transaction on simulated backend
+	Commit(backend)
+
+	return tx, nil
+}
+
+// FillTransactionSignerFields takes the transaction signer, the backend
 // and a byte array of the data to be called in a transaction.
-// It gathers necessary gas price, nonce and estimated gas and assigns
+// It gathers necessary fees, nonce and estimated gas and assigns
 // these to the fields of the transaction signer, which the function then
-// returns.
-func FillTransactionSignerFields(auth *bind.TransactOpts, client *ethclient.Client, callMsg ethereum.CallMsg) (*bind.TransactOpts, error) {
-	// Get gas price suggestion from client
-	gasPrice, err := client.SuggestGasPrice(context.Background())
+// returns. Dynamic fees are used, if the chain supports them.
+func FillTransactionSignerFields(auth *bind.TransactOpts, backend Backend, callMsg ethereum.CallMsg) (*bind.TransactOpts, error) {
+	return FillTransactionSignerFieldsWithFees(auth, backend, callMsg, DefaultFeeOptions())
+}
+
+// FillTransactionSignerFieldsWithFees fills the transaction signer fields
+// like FillTransactionSignerFields, where the fees are determined according
+// to the given fee options.
+func FillTransactionSignerFieldsWithFees(auth *bind.TransactOpts, backend Backend, callMsg ethereum.CallMsg, feeOpts FeeOptions) (*bind.TransactOpts, error) {
+	// Get fee suggestion from backend
+	fees, err := SuggestFees(context.Background(), backend, feeOpts)
 	if err != nil {
 		return nil, err
 	}
-	callMsg.GasPrice = gasPrice
+	fees.apply(auth, &callMsg)
 
 	// Get current nonce for deployer address
-	nonce, err := client.PendingNonceAt(context.Background(), auth.From)
+	nonce, err := backend.PendingNonceAt(context.Background(), auth.From)
 	if err != nil {
 		return nil, err
 	}
 
-	// Estimate gas usage
-	gasLimit, err := client.EstimateGas(context.Background(), callMsg)
+	// Estimate gas usage. Reverts are decoded with the Maltcoin ABI.
+	gasLimit, err := backend.EstimateGas(context.Background(), callMsg)
 	if err != nil {
-		return nil, err
+		return nil, DiagnoseCall(context.Background(), backend, callMsg, nil, err, maltcoinABIs()...)
 	}
 
 	// Fill transaction signer fields
@@ -93,47 +207,53 @@ func FillTransactionSignerFields(auth *bind.TransactOpts, client *ethclient.Clie
 	// auth.GasLimit = 10000000000
 	// auth.GasLimit = 11903790 // value worked
 	auth.GasLimit = gasLimit
-	auth.GasPrice = gasPrice
 	auth.Nonce = big.NewInt(int64(nonce))
 	auth.Value = big.NewInt(0)
 
 	return auth, nil
 }
 
-// GetClient connects to a local blockchain node and returns the
-// client. This is only a wrapper function to use the preconfigured
-// blockchain URL.
-func GetClient() (*ethclient.Client, error) {
+// GetClient connects to the blockchain node defined in the given network
+// profile and returns the client. The chain ID reported by the node is
+// checked against the profile, so that no transactions are sent to an
+// unexpected network.
+func GetClient(profile NetworkProfile) (*ethclient.Client, error) {
 	// Connect to blockchain node given a valid URL
-	client, err := ethclient.Dial(blockchainURL)
+	client, err := ethclient.Dial(profile.URL)
 	if err != nil {
 		return nil, err
 	}
-	fmt.Printf("Connected to local Evmos node at %s.\n", blockchainURL)
 
-	return client, err
+	// Check if the node serves the expected chain
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	if err := profile.CheckChainID(chainID); err != nil {
+		client.Close()
+		return nil, err
+	}
+	fmt.Printf("Connected to network %q at %s (chain ID %v).\n", profile.Name, profile.URL, chainID)
+
+	return client, nil
 }
 
-// GetClientAndTransactionSigner connects to a local Evmos node on port 8545,
-// queries the chain id and uses this together with the private key to create
-// a transaction signer.
+// GetClientAndTransactionSigner connects to the blockchain node defined in
+// the given network profile, queries the chain id and uses this together
+// with the private key to create a transaction signer.
 // The function returns the client and the transaction signer.
-func GetClientAndTransactionSigner(privKey *ecdsa.PrivateKey) (*ethclient.Client, *bind.TransactOpts, error) {
+func GetClientAndTransactionSigner(profile NetworkProfile, privKey *ecdsa.PrivateKey) (*ethclient.Client, *bind.TransactOpts, error) {
 	// Connect to blockchain node given a valid URL
-	client, err := GetClient()
+	client, err := GetClient(profile)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Get chain id from client in order to generate the transaction signer
-	chainID, err := client.ChainID(context.Background())
-	if err != nil {
-		return nil, nil, err
-	}
-
-	// Create transaction signer
-	auth, err := bind.NewKeyedTransactorWithChainID(privKey, chainID)
+	// Create transaction signer with the chain id from the client
+	auth, err := NewTransactionSigner(client, privKey)
 	if err != nil {
+		client.Close()
 		return nil, nil, err
 	}
 
@@ -168,7 +288,8 @@ func GeneratePrivKeysAndAddresses(n uint64) ([]*ecdsa.PrivateKey, []common.Addre
 
 // GetCallData returns the necessary byte array to fill an ethereum.CallMsg
 // struct's data field. This data is a byte representation of the method
-// call paired with its corresponding arguments.
+// call paired with its corresponding arguments. If the name is empty, only
+// the constructor arguments are packed.
 func GetCallData(name string, args ...interface{}) ([]byte, error) {
 	// Return the contract ABI in order to define the necessary transaction
 	// data.
@@ -177,8 +298,8 @@ func GetCallData(name string, args ...interface{}) ([]byte, error) {
 		return nil, err
 	}
 
-	// Generate the call data for the transfer method using the ABI
-	callData, err := maltcoinABI.Pack("transfer", args...)
+	// Generate the call data for the given method using the ABI
+	callData, err := maltcoinABI.Pack(name, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +307,34 @@ func GetCallData(name string, args ...interface{}) ([]byte, error) {
 	return callData, nil
 }
 
+// GetDeployData returns the data of a transaction, which deploys the
+// Maltcoin token contract. It consists of the contract bytecode followed
+// by the packed constructor arguments.
+func GetDeployData(args ...interface{}) ([]byte, error) {
+	constructorArgs, err := GetCallData("", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(common.FromHex(maltcoin.MaltcoinMetaData.Bin), constructorArgs...), nil
+}
+
+// GetContract returns an instance of the Maltcoin contract binding for
+// the given address. It fails, if there is no contract code stored at
+// the address.
+func GetContract(backend bind.ContractBackend, address common.Address) (*maltcoin.Maltcoin, error) {
+	// Check that there is a contract deployed at the address
+	code, err := backend.CodeAt(context.Background(), address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrNoContractCode, address)
+	}
+
+	return maltcoin.NewMaltcoin(address, backend)
+}
+
 // GetReceipt converts a given transaction hash in hex string format and
 // returns the transaction receipt, if the hash is valid.
 func GetReceipt(backend ReceiptBackend, txHashHex string) (*types.Receipt, error) {
@@ -207,8 +356,9 @@ func GetReceipt(backend ReceiptBackend, txHashHex string) (*types.Receipt, error
 // with the blockGasLimit input.
 // The private key and chain id are used to create a transaction signer for
 // any transactions on the blockchain.
-// The function returns the client and the transaction signer.
-func GetSimulatedClientAndTransactionSigner(privKey *ecdsa.PrivateKey, blockGasLimit uint64, chainID *big.Int) (*backends.SimulatedBackend, *bind.TransactOpts, error) {
+// The function returns the client, which implements the Backend interface,
+// and the transaction signer.
+func GetSimulatedClientAndTransactionSigner(privKey *ecdsa.PrivateKey, blockGasLimit uint64, chainID *big.Int) (*SimulatedClient, *bind.TransactOpts, error) {
 	// Define genesis state for simulated backend
 	address := crypto.PubkeyToAddress(privKey.PublicKey)
 	genesisAlloc := map[common.Address]core.GenesisAccount{
@@ -218,7 +368,7 @@ func GetSimulatedClientAndTransactionSigner(privKey *ecdsa.PrivateKey, blockGasL
 	}
 
 	// Get simulated backend as client
-	client := backends.NewSimulatedBackend(genesisAlloc, blockGasLimit)
+	client := &SimulatedClient{backends.NewSimulatedBackend(genesisAlloc, blockGasLimit)}
 
 	// Define transaction signer
 	auth, err := bind.NewKeyedTransactorWithChainID(privKey, chainID)
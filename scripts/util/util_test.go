@@ -9,7 +9,6 @@ import (
 	"math/big"
 	"testing"
 
-	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -19,6 +18,9 @@ import (
 var (
 	// Has to be adjusted for the tests with the running local node
 	testTxHashHex = "0xa9f7d8cb3a5a84c8740cd106c5334bdb13d09d4b81087a681fbc3ad2860dc557"
+
+	// Network profile of the running local node
+	testNetwork = DefaultNetworkConfig().Networks[DefaultNetworkName]
 )
 
 // TestDeployContractAndCommit tests wether the generated contract bindings
@@ -62,7 +64,7 @@ func TestDeployContractAndCommit(t *testing.T) {
 			require.NoError(t, err, "Error getting client and transaction signer")
 
 			// Deploy contract
-			_, _, _, err = DeployContractAndCommit(auth, client)
+			_, _, _, err = DeployContractAndCommit(auth, client, DefaultTokenSettings())
 			if tc.expErr {
 				require.Error(t, err, "Deployed contract")
 			} else {
@@ -75,11 +77,13 @@ func TestDeployContractAndCommit(t *testing.T) {
 // TestFillTransactionSignerFields tests different configurations of client
 // and call data to test the filling of the transaction signer fields.
 // These fields include the nonce, gas price, gas limit and value.
-//
-// These tests need an instance of the local node running!
+// The same code path is used for a running node, so it is tested on a
+// simulated backend.
 func TestFillTransactionSignerFields(t *testing.T) {
-	privKey, err := crypto.GenerateKey()
+	privKeys, _, err := GeneratePrivKeysAndAddresses(1)
 	require.NoError(t, err, "Error generating private key")
+	deployData, err := GetDeployData(DefaultTokenSettings().Args()...)
+	require.NoError(t, err, "Error getting deployment data")
 
 	testcases := []struct {
 		name     string
@@ -92,9 +96,9 @@ func TestFillTransactionSignerFields(t *testing.T) {
 			[]byte{},
 		},
 		{
-			"passes - deployment bin as data",
+			"passes - deployment data",
 			false,
-			common.FromHex(maltcoin.MaltcoinMetaData.Bin),
+			deployData,
 		},
 		{
 			"fails - invalid data",
@@ -105,7 +109,7 @@ func TestFillTransactionSignerFields(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			client, auth, err := GetClientAndTransactionSigner(privKey)
+			client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
 			require.NoError(t, err, "Error getting client and transaction signer")
 
 			// Define call msg
@@ -114,11 +118,14 @@ func TestFillTransactionSignerFields(t *testing.T) {
 				Data: tc.callData,
 			}
 
-			_, err = FillTransactionSignerFields(auth, client, msg)
+			auth, err = FillTransactionSignerFields(auth, client, msg)
 			if tc.expErr {
 				require.Error(t, err, "Error filling the transaction signer fields")
 			} else {
 				require.NoError(t, err, "Error filling the transaction signer fields")
+				require.Equal(t, big.NewInt(0), auth.Nonce, "Wrong nonce")
+				require.NotZero(t, auth.GasLimit, "Gas limit should be estimated")
+				require.NotNil(t, auth.GasFeeCap, "Simulated backend should use dynamic fees")
 			}
 		})
 	}
@@ -142,12 +149,30 @@ func TestGetCallData(t *testing.T) {
 				big.NewInt(1),
 			},
 		},
+		{
+			"passes - approve call",
+			false,
+			"approve",
+			[]interface{}{
+				common.HexToAddress("0x1234567890123456789012345678901234567890"),
+				big.NewInt(1),
+			},
+		},
 		{
 			"fails - invalid method name",
 			true,
 			"InvalidMethodName",
 			[]interface{}{},
 		},
+		{
+			"fails - wrong arguments for method",
+			true,
+			"transferFrom",
+			[]interface{}{
+				common.HexToAddress("0x1234567890123456789012345678901234567890"),
+				big.NewInt(1),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -167,7 +192,7 @@ func TestGetCallData(t *testing.T) {
 // For this purpose, the local node has to be running.
 func TestGetClient(t *testing.T) {
 	// Connect to local node
-	client, err := GetClient()
+	client, err := GetClient(testNetwork)
 	require.NoError(t, err, "Error getting client")
 
 	// Check if chain ID is as expected
@@ -180,7 +205,7 @@ func TestGetClient(t *testing.T) {
 // be retrieved using a connection to a local node.
 func TestGetReceipt(t *testing.T) {
 	// Get client
-	client, err := GetClient()
+	client, err := GetClient(testNetwork)
 	require.NoError(t, err, "Error getting client and transaction signer")
 
 	// Get receipt for valid transaction
@@ -205,7 +230,7 @@ func TestGetReceiptSimulated(t *testing.T) {
 	require.NoError(t, err, "Error getting simulated client and transaction signer")
 
 	// Deploy contract
-	_, tx, _, err := DeployContractAndCommit(auth, client)
+	_, tx, _, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
 	require.NoError(t, err, "Error deploying contract")
 
 	fmt.Println("Deployed contract:", tx.Hash().Hex())
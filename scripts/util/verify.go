@@ -0,0 +1,239 @@
+// verify.go contains the verification of deployed contract code against the
+// runtime bytecode, which is computed from the build artifacts.
+package util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Results of the verification of deployed code
+const (
+	CodeMatch    = "match"
+	CodeMismatch = "mismatch"
+	NotAContract = "not_a_contract"
+)
+
+// diffWindowLen is the number of bytes, which are shown from the first
+// difference on.
+const diffWindowLen = 16
+
+// ErrCodeMismatch is returned, when the code deployed at an address differs
+// from the runtime bytecode of the artifact.
+var ErrCodeMismatch = errors.New("deployed code does not match the artifact")
+
+// CodeRange is a range of bytes in the runtime bytecode of a contract.
+type CodeRange struct {
+	Start  int
+	Length int
+}
+
+// RuntimeCode contains the runtime bytecode of a contract, which is returned
+// by its constructor, together with the ranges of the immutable variables.
+// The values of immutable variables are written into the bytecode by the
+// constructor and can differ between deployments.
+type RuntimeCode struct {
+	Code       []byte
+	Immutables []CodeRange
+}
+
+// CodeVerification is the result of comparing the code deployed at an
+// address with the expected runtime bytecode.
+type CodeVerification struct {
+	Address common.Address
+	// Status is CodeMatch, CodeMismatch or NotAContract.
+	Status       string
+	ExpectedSize int
+	ActualSize   int
+	// MetadataMatch is true, if the metadata hashes, which solc appends to
+	// the bytecode, are equal. Different metadata, e.g. from a different
+	// source path, do not cause a mismatch.
+	MetadataMatch bool
+	// Offset is the position of the first differing byte or -1, if the code
+	// matches. The differing bytes are contained in Expected and Actual.
+	Offset   int
+	Expected []byte
+	Actual   []byte
+}
+
+// Err returns ErrCodeMismatch or ErrNoContractCode, if the deployed code does
+// not match.
+func (v *CodeVerification) Err() error {
+	switch v.Status {
+	case CodeMatch:
+		return nil
+	case NotAContract:
+		return fmt.Errorf("%w %s", ErrNoContractCode, v.Address)
+	default:
+		return fmt.Errorf("%w at %s: first difference at byte %d of %d", ErrCodeMismatch, v.Address, v.Offset, v.ActualSize)
+	}
+}
+
+// RuntimeCode executes the constructor of the artifact with the given
+// arguments in an in-memory EVM and returns the resulting runtime bytecode.
+// The constructor is executed twice in different environments, so that the
+// operands of the immutable variables can be detected by their differences.
+func (a *Artifact) RuntimeCode(args ...interface{}) (*RuntimeCode, error) {
+	constructorArgs, err := a.ABI.Pack("", args...)
+	if err != nil {
+		return nil, err
+	}
+	input := append(common.CopyBytes(a.Bin), constructorArgs...)
+
+	var codes [2][]byte
+	for i := range codes {
+		chainConfig := *params.AllEthashProtocolChanges
+		chainConfig.ChainID = big.NewInt(int64(1337 + i))
+		env := big.NewInt(int64(i + 1))
+		code, _, _, err := runtime.Create(input, &runtime.Config{
+			ChainConfig: &chainConfig,
+			Origin:      common.BigToAddress(new(big.Int).Add(env, big.NewInt(0xf000))),
+			Coinbase:    common.BigToAddress(env),
+			BlockNumber: env,
+			Time:        env,
+			Difficulty:  env,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute constructor of %s: %w", a.Name, err)
+		}
+		codes[i] = code
+	}
+	if len(codes[0]) != len(codes[1]) {
+		return nil, fmt.Errorf("runtime bytecode of %s depends on the deployment environment", a.Name)
+	}
+
+	return &RuntimeCode{Code: codes[0], Immutables: immutableRanges(codes[0], codes[1])}, nil
+}
+
+// immutableRanges returns the ranges of the push operands, which differ
+// between the two given runtime bytecodes. Immutable variables are pushed
+// onto the stack as full operands, so that a whole operand is ignored, even
+// if only some of its bytes differ.
+func immutableRanges(a, b []byte) []CodeRange {
+	var ranges []CodeRange
+	for i := 0; i < len(a); i++ {
+		op := vm.OpCode(a[i])
+		if op < vm.PUSH1 || op > vm.PUSH32 {
+			if a[i] != b[i] {
+				ranges = append(ranges, CodeRange{Start: i, Length: 1})
+			}
+			continue
+		}
+
+		start := i + 1
+		end := start + int(op-vm.PUSH1) + 1
+		if end > len(a) {
+			end = len(a)
+		}
+		if !bytes.Equal(a[start:end], b[start:end]) {
+			ranges = append(ranges, CodeRange{Start: start, Length: end - start})
+		}
+		i = end - 1
+	}
+
+	return ranges
+}
+
+// splitMetadata splits the given bytecode into the executable code and the
+// CBOR encoded metadata, which solc appends to the bytecode. The length of
+// the metadata is stored in the last two bytes.
+func splitMetadata(code []byte) ([]byte, []byte) {
+	if len(code) < 2 {
+		return code, nil
+	}
+	length := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - length
+	// The metadata is encoded as CBOR map with up to 23 entries
+	if length == 0 || start < 0 || code[start] < 0xa1 || code[start] > 0xb7 {
+		return code, nil
+	}
+
+	return code[:start], code[start:]
+}
+
+// CompareCode compares the given deployed code with the expected runtime
+// bytecode. The metadata hash and the operands of immutable variables are
+// ignored.
+func CompareCode(expected *RuntimeCode, actual []byte) *CodeVerification {
+	verification := &CodeVerification{
+		Status:       CodeMatch,
+		ExpectedSize: len(expected.Code),
+		ActualSize:   len(actual),
+		Offset:       -1,
+	}
+	if len(actual) == 0 {
+		verification.Status = NotAContract
+		return verification
+	}
+
+	expectedCode, expectedMetadata := splitMetadata(expected.Code)
+	actualCode, actualMetadata := splitMetadata(actual)
+	verification.MetadataMatch = bytes.Equal(expectedMetadata, actualMetadata)
+
+	ignored := make([]bool, len(expectedCode))
+	for _, immutable := range expected.Immutables {
+		for i := immutable.Start; i < immutable.Start+immutable.Length && i < len(ignored); i++ {
+			ignored[i] = true
+		}
+	}
+
+	offset := -1
+	for i := 0; i < len(expectedCode) && i < len(actualCode); i++ {
+		if !ignored[i] && expectedCode[i] != actualCode[i] {
+			offset = i
+			break
+		}
+	}
+	if offset < 0 && len(expectedCode) != len(actualCode) {
+		offset = len(expectedCode)
+		if len(actualCode) < offset {
+			offset = len(actualCode)
+		}
+	}
+	if offset >= 0 {
+		verification.Status = CodeMismatch
+		verification.Offset = offset
+		verification.Expected = diffWindow(expected.Code, offset)
+		verification.Actual = diffWindow(actual, offset)
+	}
+
+	return verification
+}
+
+// diffWindow returns the bytes of the given code from the given offset on,
+// which are printed to show a difference.
+func diffWindow(code []byte, offset int) []byte {
+	if offset >= len(code) {
+		return nil
+	}
+	end := offset + diffWindowLen
+	if end > len(code) {
+		end = len(code)
+	}
+
+	return code[offset:end]
+}
+
+// VerifyCode compares the code deployed at the given address with the
+// expected runtime bytecode. A mismatch is reported in the returned
+// verification and not as error.
+func VerifyCode(ctx context.Context, backend bind.ContractCaller, address common.Address, expected *RuntimeCode) (*CodeVerification, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve code: %w", err)
+	}
+
+	verification := CompareCode(expected, code)
+	verification.Address = address
+
+	return verification, nil
+}
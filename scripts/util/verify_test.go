@@ -0,0 +1,159 @@
+// verify_test.go contains the unit tests for verifying deployed contract
+// code against the build artifacts.
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRuntimeCode tests, that the runtime bytecode computed from the
+// artifact equals the code of a deployed contract.
+func TestRuntimeCode(t *testing.T) {
+	privKeys, _, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private key")
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting client and transaction signer")
+	contractAddress, _, _, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	artifact, err := MaltcoinArtifact()
+	require.NoError(t, err)
+	expected, err := artifact.RuntimeCode(DefaultTokenSettings().Args()...)
+	require.NoError(t, err, "Error computing runtime code")
+	// The cached EIP-712 domain separator, chain ID and contract address of
+	// the permit extension depend on the deployment
+	require.Len(t, expected.Immutables, 3, "Maltcoin should have three environment dependent immutable variables")
+
+	code, err := client.CodeAt(context.Background(), contractAddress, nil)
+	require.NoError(t, err)
+	require.Len(t, code, len(expected.Code))
+	verification := CompareCode(expected, code)
+	require.Equal(t, CodeMatch, verification.Status, "Runtime code should match the deployed code")
+	require.True(t, verification.MetadataMatch)
+
+	_, err = artifact.RuntimeCode("unexpected")
+	require.Error(t, err, "Constructor arguments should be checked")
+}
+
+// TestImmutableRanges tests detecting the push operands, which differ
+// between two runtime bytecodes.
+func TestImmutableRanges(t *testing.T) {
+	testcases := []struct {
+		name string
+		a    []byte
+		b    []byte
+		exp  []CodeRange
+	}{
+		{"equal code", []byte{0x60, 0x01, 0x00}, []byte{0x60, 0x01, 0x00}, nil},
+		{"differing operand", []byte{0x00, 0x62, 0x01, 0x02, 0x03, 0x00}, []byte{0x00, 0x62, 0x01, 0x07, 0x03, 0x00}, []CodeRange{{2, 3}}},
+		{"push data is not an opcode", []byte{0x61, 0x60, 0x01, 0x00}, []byte{0x61, 0x60, 0x02, 0x00}, []CodeRange{{1, 2}}},
+		{"truncated operand", []byte{0x00, 0x63, 0x01}, []byte{0x00, 0x63, 0x02}, []CodeRange{{2, 1}}},
+		{"differing opcode", []byte{0x00, 0x01}, []byte{0x00, 0x02}, []CodeRange{{1, 1}}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, immutableRanges(tc.a, tc.b))
+		})
+	}
+}
+
+// TestCompareCode tests comparing deployed code with the expected runtime
+// bytecode, while ignoring the metadata and immutable variables.
+func TestCompareCode(t *testing.T) {
+	artifact, err := MaltcoinArtifact()
+	require.NoError(t, err)
+	expected, err := artifact.RuntimeCode(DefaultTokenSettings().Args()...)
+	require.NoError(t, err)
+	code, metadata := splitMetadata(expected.Code)
+	require.NotEmpty(t, metadata, "Runtime code should contain metadata")
+
+	// modified returns a copy of the runtime code with a changed byte
+	modified := func(offset int) []byte {
+		bz := common.CopyBytes(expected.Code)
+		bz[offset] ^= 0xff
+		return bz
+	}
+	withImmutable := &RuntimeCode{Code: expected.Code, Immutables: []CodeRange{{Start: 100, Length: 32}}}
+
+	testcases := []struct {
+		name        string
+		expected    *RuntimeCode
+		actual      []byte
+		expStatus   string
+		expOffset   int
+		expMetadata bool
+	}{
+		{"passes - same code", expected, expected.Code, CodeMatch, -1, true},
+		{"passes - different metadata", expected, modified(len(expected.Code) - 5), CodeMatch, -1, false},
+		{"passes - different immutable", withImmutable, modified(110), CodeMatch, -1, true},
+		{"fails - different byte", expected, modified(110), CodeMismatch, 110, true},
+		{"fails - truncated code", expected, append(common.CopyBytes(code[:200]), metadata...), CodeMismatch, 200, true},
+		{"fails - no code", expected, nil, NotAContract, -1, false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			verification := CompareCode(tc.expected, tc.actual)
+			require.Equal(t, tc.expStatus, verification.Status)
+			require.Equal(t, tc.expOffset, verification.Offset)
+			require.Equal(t, tc.expMetadata, verification.MetadataMatch)
+			if tc.expStatus == CodeMatch {
+				require.NoError(t, verification.Err())
+				return
+			}
+			require.Error(t, verification.Err())
+			if tc.expStatus == CodeMismatch {
+				require.ErrorIs(t, verification.Err(), ErrCodeMismatch)
+				require.NotEqual(t, verification.Expected, verification.Actual, "Differing bytes should be shown")
+			} else {
+				require.ErrorIs(t, verification.Err(), ErrNoContractCode)
+			}
+		})
+	}
+}
+
+// TestVerifyCode tests verifying the code at addresses on the simulated
+// backend.
+func TestVerifyCode(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private key")
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting client and transaction signer")
+	contractAddress, _, _, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	artifact, err := MaltcoinArtifact()
+	require.NoError(t, err)
+	expected, err := artifact.RuntimeCode(DefaultTokenSettings().Args()...)
+	require.NoError(t, err)
+
+	verification, err := VerifyCode(context.Background(), client, contractAddress, expected)
+	require.NoError(t, err, "Error verifying code")
+	require.Equal(t, contractAddress, verification.Address)
+	require.Equal(t, CodeMatch, verification.Status)
+
+	verification, err = VerifyCode(context.Background(), client, addresses[0], expected)
+	require.NoError(t, err, "Error verifying code")
+	require.Equal(t, NotAContract, verification.Status, "Account should not be a contract")
+
+	// The hash of the name is an immutable variable of the EIP-712 domain,
+	// so that the code of a custom token only matches its own settings
+	settings := DefaultTokenSettings()
+	settings.Name = "Staging Token"
+	customAddress, _, _, err := DeployContractAndCommit(auth, client, settings)
+	require.NoError(t, err, "Error deploying custom token")
+	verification, err = VerifyCode(context.Background(), client, customAddress, expected)
+	require.NoError(t, err, "Error verifying code")
+	require.Equal(t, CodeMismatch, verification.Status, "Default settings should not match a custom name")
+
+	expected, err = artifact.RuntimeCode(settings.Args()...)
+	require.NoError(t, err)
+	verification, err = VerifyCode(context.Background(), client, customAddress, expected)
+	require.NoError(t, err, "Error verifying code")
+	require.Equal(t, CodeMatch, verification.Status, "Custom settings should match the custom token")
+}
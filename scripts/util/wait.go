@@ -0,0 +1,123 @@
+// wait.go contains the functions to wait for transactions to be included
+// in a block and confirmed by a number of subsequent blocks.
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrWaitTimeout is returned, when a transaction is not confirmed
+	// within the configured timeout.
+	ErrWaitTimeout = errors.New("timed out waiting for transaction")
+
+	// ErrTransactionFailed is returned, when a transaction was included
+	// in a block, but its execution failed.
+	ErrTransactionFailed = errors.New("transaction failed")
+)
+
+// WaitBackend defines an interface, which can be used to wait for the
+// confirmation of transactions on an ethclient or simulated backend.
+type WaitBackend interface {
+	ReceiptBackend
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// WaitOptions define how long and how often the receipt of a transaction
+// is queried and how many blocks are required to confirm it.
+type WaitOptions struct {
+	// Timeout is the maximum time to wait for the confirmation.
+	Timeout time.Duration
+	// PollInterval is the time between two queries of the receipt.
+	PollInterval time.Duration
+	// Confirmations is the number of blocks, including the block containing
+	// the transaction, that have to be produced before the transaction is
+	// considered confirmed.
+	Confirmations uint64
+}
+
+// DefaultWaitOptions returns the wait options, which are used if not
+// configured otherwise.
+func DefaultWaitOptions() WaitOptions {
+	return WaitOptions{
+		Timeout:       2 * time.Minute,
+		PollInterval:  time.Second,
+		Confirmations: 1,
+	}
+}
+
+// WaitForTransaction polls the receipt of the transaction with the given
+// hash until the transaction is included in a block and the required number
+// of confirmations is reached.
+// The receipt is returned together with ErrTransactionFailed, if the
+// transaction was included but failed. If the transaction is not confirmed
+// in time, ErrWaitTimeout is returned.
+func WaitForTransaction(ctx context.Context, backend WaitBackend, txHash common.Hash, opts WaitOptions) (*types.Receipt, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultWaitOptions().PollInterval
+	}
+	if opts.Confirmations == 0 {
+		opts.Confirmations = 1
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		receipt, err := backend.TransactionReceipt(ctx, txHash)
+		switch {
+		case err == nil:
+			confirmed, err := isConfirmed(ctx, backend, receipt, opts.Confirmations)
+			if err != nil {
+				lastErr = err
+			} else if confirmed {
+				if receipt.Status != types.ReceiptStatusSuccessful {
+					return receipt, fmt.Errorf("%w: %s in block %v", ErrTransactionFailed, txHash.Hex(), receipt.BlockNumber)
+				}
+				return receipt, nil
+			}
+		case !errors.Is(err, ethereum.NotFound):
+			lastErr = err
+		}
+
+		// Wait for the next round.
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return nil, fmt.Errorf("%w %s: %v (last error: %v)", ErrWaitTimeout, txHash.Hex(), ctx.Err(), lastErr)
+			}
+			return nil, fmt.Errorf("%w %s: %v", ErrWaitTimeout, txHash.Hex(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// isConfirmed checks if the latest block is at least the given number of
+// confirmations ahead of the block, which contains the receipt.
+func isConfirmed(ctx context.Context, backend WaitBackend, receipt *types.Receipt, confirmations uint64) (bool, error) {
+	if confirmations <= 1 {
+		return true, nil
+	}
+
+	header, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	required := new(big.Int).Add(receipt.BlockNumber, new(big.Int).SetUint64(confirmations-1))
+	return header.Number.Cmp(required) >= 0, nil
+}
@@ -0,0 +1,129 @@
+// wait_test.go contains the unit tests for waiting on transaction
+// confirmations, which are run against a simulated backend.
+package util
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWaitForTransaction tests waiting for successful, failed and
+// pending transactions with different numbers of confirmations.
+func TestWaitForTransaction(t *testing.T) {
+	privKeys, addresses, err := GeneratePrivKeysAndAddresses(2)
+	require.NoError(t, err, "Error generating private keys")
+
+	testcases := []struct {
+		name          string
+		expErr        error
+		confirmations uint64
+		// commits is the number of blocks committed after the transaction was sent
+		commits int
+		// failing defines if the sent transaction should revert
+		failing bool
+	}{
+		{
+			"passes - included transaction",
+			nil,
+			1,
+			1,
+			false,
+		},
+		{
+			"passes - confirmed transaction",
+			nil,
+			3,
+			3,
+			false,
+		},
+		{
+			"fails - not enough confirmations",
+			ErrWaitTimeout,
+			3,
+			2,
+			false,
+		},
+		{
+			"fails - pending transaction",
+			ErrWaitTimeout,
+			1,
+			0,
+			false,
+		},
+		{
+			"fails - reverted transaction",
+			ErrTransactionFailed,
+			1,
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+			require.NoError(t, err, "Error getting simulated client and transaction signer")
+
+			_, _, contract, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+			require.NoError(t, err, "Error deploying contract")
+
+			// Transferring more than the balance reverts the transaction. The gas
+			// limit is set manually, because the gas estimation would fail.
+			amount := big.NewInt(1)
+			if tc.failing {
+				amount = new(big.Int).Mul(big.NewInt(1e6), Ten18)
+				auth.GasLimit = 100000
+			}
+			tx, err := contract.Transfer(auth, addresses[1], amount)
+			require.NoError(t, err, "Error sending transfer")
+
+			for i := 0; i < tc.commits; i++ {
+				client.Commit()
+			}
+
+			opts := WaitOptions{
+				Timeout:       200 * time.Millisecond,
+				PollInterval:  10 * time.Millisecond,
+				Confirmations: tc.confirmations,
+			}
+			receipt, err := WaitForTransaction(context.Background(), client, tx.Hash(), opts)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr, "Wrong error waiting for transaction")
+			} else {
+				require.NoError(t, err, "Error waiting for transaction")
+				require.Equal(t, tx.Hash(), receipt.TxHash, "Wrong receipt")
+			}
+		})
+	}
+}
+
+// TestWaitForTransactionCommitsDuringWait tests that a transaction, which is
+// mined while waiting, is picked up by the polling.
+func TestWaitForTransactionCommitsDuringWait(t *testing.T) {
+	privKeys, _, err := GeneratePrivKeysAndAddresses(1)
+	require.NoError(t, err, "Error generating private keys")
+
+	client, auth, err := GetSimulatedClientAndTransactionSigner(privKeys[0], MaxGasPerBlock, TestChainID)
+	require.NoError(t, err, "Error getting simulated client and transaction signer")
+
+	_, tx, _, err := DeployContractAndCommit(auth, client, DefaultTokenSettings())
+	require.NoError(t, err, "Error deploying contract")
+
+	// Produce two more blocks in the background.
+	go func() {
+		for i := 0; i < 2; i++ {
+			time.Sleep(20 * time.Millisecond)
+			client.Commit()
+		}
+	}()
+
+	opts := WaitOptions{Timeout: 5 * time.Second, PollInterval: 5 * time.Millisecond, Confirmations: 3}
+	receipt, err := WaitForTransaction(context.Background(), client, tx.Hash(), opts)
+	require.NoError(t, err, "Error waiting for transaction")
+	require.NotEqual(t, common.Address{}, receipt.ContractAddress, "Deployment receipt should contain the contract address")
+}
@@ -10,6 +10,7 @@ import (
 	"log"
 	"math/big"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -17,7 +18,6 @@ import (
 	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
 	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/suite"
@@ -31,11 +31,12 @@ type MaltcoinTestSuite struct {
 
 	addresses       []common.Address
 	auth            *bind.TransactOpts
-	client          *backends.SimulatedBackend
+	client          *util.SimulatedClient
 	contract        *maltcoin.Maltcoin
 	contractAddress common.Address
 	deployerBalance *big.Int
 	privKeys        []*ecdsa.PrivateKey
+	settings        util.TokenSettings
 }
 
 // Initialize the test suite
@@ -46,7 +47,7 @@ var s *MaltcoinTestSuite
 // to a simulated backend.
 func (suite *MaltcoinTestSuite) SetupTest() {
 	// Generate testing accounts
-	privKeys, addresses, err := util.GeneratePrivKeysAndAddresses(3)
+	privKeys, addresses, err := util.DerivePrivKeysAndAddresses(util.TestMnemonic, "", 3)
 	if err != nil {
 		log.Fatalf("Error generating private key: %v\n", err)
 	}
@@ -55,8 +56,12 @@ func (suite *MaltcoinTestSuite) SetupTest() {
 	client, auth, _ := util.GetSimulatedClientAndTransactionSigner(privKeys[0], util.MaxGasPerBlock, util.TestChainID)
 	// require.NoError(s.T(), err, "Error getting client and transaction signer")
 
-	// Deploy contract
-	contractAddress, _, contract, _ := util.DeployContractAndCommit(auth, client)
+	// Deploy contract with the settings of the suite, which default to
+	// the Maltcoin settings
+	if suite.settings.Name == "" {
+		suite.settings = util.DefaultTokenSettings()
+	}
+	contractAddress, _, contract, _ := util.DeployContractAndCommit(auth, client, suite.settings)
 	// require.NoError(s.T(), err, "Could not deploy contract")
 
 	// Assign to testing suite
@@ -65,7 +70,10 @@ func (suite *MaltcoinTestSuite) SetupTest() {
 	suite.client = client
 	suite.contract = contract
 	suite.contractAddress = contractAddress
-	suite.deployerBalance = new(big.Int).Mul(big.NewInt(10000), util.Ten18) // 10000 MALT
+	suite.deployerBalance = new(big.Int)
+	if suite.settings.Holder(auth.From) == auth.From {
+		suite.deployerBalance = suite.settings.InitialSupply
+	}
 	suite.privKeys = privKeys
 }
 
@@ -101,6 +109,87 @@ var _ = Describe("approve:", func() {
 	})
 })
 
+var _ = Describe("permit:", func() {
+	// Define permitted amount
+	amount := util.Ten18
+
+	// The owner holds tokens, but no native tokens to pay fees, so that the
+	// deployer relays the signed permits
+	var permit *util.SignedPermit
+
+	BeforeEach(func() {
+		s.SetupTest()
+
+		_, err := s.contract.Transfer(s.auth, s.addresses[1], amount)
+		Expect(err).To(BeNil())
+		s.client.Commit()
+
+		deadline, err := util.PermitDeadline(context.Background(), s.client, time.Hour)
+		Expect(err).To(BeNil())
+		permit, err = util.SignPermit(context.Background(), s.client, s.contractAddress, s.privKeys[1], s.addresses[0], amount, deadline)
+		Expect(err).To(BeNil())
+	})
+
+	// submit sends the signed permit from the deployer account and commits
+	// the transaction, if it was sent
+	submit := func(permit *util.SignedPermit) error {
+		_, err := s.contract.Permit(s.auth, permit.Owner, permit.Spender, permit.Value, permit.Deadline, permit.V, permit.R, permit.S)
+		if err == nil {
+			s.client.Commit()
+		}
+		return err
+	}
+
+	Context("When a relayer submits the signed permit", func() {
+		BeforeEach(func() {
+			Expect(submit(permit)).To(Succeed())
+		})
+
+		It("should have added the amount to the allowance of the spender", func() {
+			allowance, err := s.contract.Allowance(nil, s.addresses[1], s.addresses[0])
+			Expect(allowance.Cmp(amount), err).To(Equal(0))
+		})
+
+		It("should allow the spender to transfer the tokens of the owner", func() {
+			_, err := s.contract.TransferFrom(s.auth, s.addresses[1], s.addresses[2], amount)
+			Expect(err).To(BeNil())
+			s.client.Commit()
+
+			balance, err := s.contract.BalanceOf(nil, s.addresses[2])
+			Expect(balance.Cmp(amount), err).To(Equal(0))
+		})
+
+		It("should reject the permit, when it is replayed", func() {
+			Expect(util.CheckPermit(context.Background(), s.client, permit)).To(MatchError(util.ErrPermitNonce))
+			Expect(submit(permit)).To(MatchError(ContainSubstring("ERC20Permit: invalid signature")))
+		})
+	})
+
+	Context("When the deadline of the permit has passed", func() {
+		BeforeEach(func() {
+			Expect(s.client.AdjustTime(2 * time.Hour)).To(Succeed())
+			s.client.Commit()
+		})
+
+		It("should reject the permit", func() {
+			Expect(util.CheckPermit(context.Background(), s.client, permit)).To(MatchError(util.ErrPermitExpired))
+			Expect(submit(permit)).To(MatchError(ContainSubstring("ERC20Permit: expired deadline")))
+
+			allowance, err := s.contract.Allowance(nil, s.addresses[1], s.addresses[0])
+			Expect(allowance.Sign(), err).To(Equal(0))
+		})
+	})
+
+	Context("When the permit was modified", func() {
+		It("should reject the permit", func() {
+			modified := *permit
+			modified.Value = new(big.Int).Mul(amount, big.NewInt(2))
+			Expect(util.CheckPermit(context.Background(), s.client, &modified)).To(MatchError(util.ErrInvalidPermit))
+			Expect(submit(&modified)).To(MatchError(ContainSubstring("ERC20Permit: invalid signature")))
+		})
+	})
+})
+
 var _ = Describe("balance:", func() {
 	BeforeEach(func() {
 		s.SetupTest()
@@ -123,6 +212,42 @@ var _ = Describe("balance:", func() {
 	})
 })
 
+var _ = Describe("settings:", func() {
+	// Define the settings of a token for another environment, whose supply
+	// is assigned to another account than the deployer
+	settings := util.TokenSettings{Name: "Maltcoin Testnet", Symbol: "tMALT", InitialSupply: big.NewInt(1e18)}
+
+	BeforeEach(func() {
+		_, addresses, err := util.DerivePrivKeysAndAddresses(util.TestMnemonic, "", 3)
+		Expect(err).To(BeNil())
+
+		s.settings = settings
+		s.settings.InitialHolder = addresses[2]
+		s.SetupTest()
+	})
+
+	AfterEach(func() {
+		// Restore the default settings for the other specs
+		s.settings = util.TokenSettings{}
+	})
+
+	Context("Token deployed with custom settings", func() {
+		It("should have the given name and symbol", func() {
+			name, err := s.contract.Name(nil)
+			Expect(name, err).To(Equal(settings.Name))
+			symbol, err := s.contract.Symbol(nil)
+			Expect(symbol, err).To(Equal(settings.Symbol))
+		})
+
+		It("should have assigned the initial supply to the initial holder", func() {
+			balance, err := s.contract.BalanceOf(nil, s.settings.InitialHolder)
+			Expect(balance.Cmp(settings.InitialSupply), err).To(Equal(0))
+			balance, err = s.contract.BalanceOf(nil, s.addresses[0])
+			Expect(balance.Cmp(s.deployerBalance), err).To(Equal(0))
+		})
+	})
+})
+
 var _ = Describe("transfer:", func() {
 	BeforeEach(func() {
 		s.SetupTest()
@@ -188,8 +313,11 @@ var _ = Describe("transferFrom:", func() {
 		// tests, an appropriate amount of Evmos is sent to the sender address,
 		// which upon genesis of the simulated backend, does not hold any.
 		//
-		// Define transaction
-		tx := types.NewTransaction(1, s.addresses[1], big.NewInt(1e15), util.MaxGasPerBlock, big.NewInt(817704169), nil)
+		// Define transaction with the gas price suggested by the backend,
+		// which covers the base fee after the deployment
+		gasPrice, err := s.client.SuggestGasPrice(context.Background())
+		Expect(err).To(BeNil())
+		tx := types.NewTransaction(1, s.addresses[1], big.NewInt(1e15), util.MaxGasPerBlock, gasPrice, nil)
 
 		// Sign the transaction with the private key of the deployer account
 		signedTx, err := types.SignTx(tx, types.NewEIP155Signer(util.TestChainID), s.privKeys[0])
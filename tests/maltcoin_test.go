@@ -1,7 +1,8 @@
 // maltcoin_test.go contains the testing suite for the Maltcoin smart contract.
 //
 // The token properties and functions are tested using a simulated go-ethereum
-// backend and private keys generated by the crypto package.
+// backend and accounts, which are derived deterministically from the test
+// mnemonic, so that failures can be reproduced.
 package maltcoin_tests
 
 import (
@@ -24,7 +25,7 @@ import (
 // which is 10000 MALT and the other should have 0.
 func TestTokenBalance(t *testing.T) {
 	// Generate testing accounts
-	privKeys, addresses, err := util.GeneratePrivKeysAndAddresses(2)
+	privKeys, addresses, err := util.DerivePrivKeysAndAddresses(util.TestMnemonic, "", 2)
 	if err != nil {
 		log.Fatalf("Error generating private key: %v\n", err)
 	}
@@ -34,11 +35,12 @@ func TestTokenBalance(t *testing.T) {
 	require.NoError(t, err, "Error getting client and transaction signer")
 
 	// Deploy contract
-	_, _, contract, err := util.DeployContractAndCommit(auth, client)
+	_, _, contract, err := util.DeployContractAndCommit(auth, client, util.DefaultTokenSettings())
 	require.NoError(t, err, "Could not deploy contract")
 
-	// Define 10000 * 10^18 as a big integer.
-	initialDeployerBalance := new(big.Int).Mul(big.NewInt(10000), util.Ten18)
+	// The deployer holds the initial supply of the default settings,
+	// which is 10000 * 10^18.
+	initialDeployerBalance := util.DefaultTokenSettings().InitialSupply
 
 	// Check maltcoin token balance of account1
 	// This account deployed the contract, so it should have the initial deployer token balance.
@@ -60,7 +62,7 @@ func TestTokenBalance(t *testing.T) {
 // backend, a transfer is executed and the account balances are checked.
 func TestTokenTransfer(t *testing.T) {
 	// Generate testing accounts
-	privKeys, addresses, err := util.GeneratePrivKeysAndAddresses(2)
+	privKeys, addresses, err := util.DerivePrivKeysAndAddresses(util.TestMnemonic, "", 2)
 	if err != nil {
 		log.Fatalf("Error generating private key: %v\n", err)
 	}
@@ -70,14 +72,14 @@ func TestTokenTransfer(t *testing.T) {
 	require.NoError(t, err, "Error getting client and transaction signer")
 
 	// Deploy contract
-	_, _, contract, err := util.DeployContractAndCommit(auth, client)
+	_, _, contract, err := util.DeployContractAndCommit(auth, client, util.DefaultTokenSettings())
 	require.NoError(t, err, "Could not deploy contract")
 
 	// Get maltcoin token balance of account1
 	//
 	// Since account1 deployed the contract, it is assigned the deployer token balance.
 	// This is 10000 * 10^18 in this case.
-	initialDeployerBalance := new(big.Int).Mul(big.NewInt(10000), util.Ten18)
+	initialDeployerBalance := util.DefaultTokenSettings().InitialSupply
 
 	// Transfer tokens from account1 to account2
 	amount := util.Ten18
@@ -96,7 +98,9 @@ func TestTokenTransfer(t *testing.T) {
 }
 
 // TestTokenSettings tests if the smart contract returns the expected
-// token properties (name, symbol, decimals, total supply)..
+// token properties (name, symbol, decimals, total supply) for the settings,
+// which it was deployed with, and if the initial supply is assigned to the
+// initial holder.
 // It checks if any errors are produced
 func TestTokenSettings(t *testing.T) {
 	// Test parameters
@@ -107,35 +111,69 @@ func TestTokenSettings(t *testing.T) {
 	// the simulated backend must be 1337.
 	chainID := big.NewInt(1337)
 
-	// Generate private key
+	// Generate private key and the address of another holder
 	privKey, err := crypto.GenerateKey()
 	require.NoError(t, err, "Error generating private key")
+	holderKey, err := crypto.GenerateKey()
+	require.NoError(t, err, "Error generating private key")
+	holder := crypto.PubkeyToAddress(holderKey.PublicKey)
+
+	testcases := []struct {
+		name           string
+		settings       util.TokenSettings
+		expTotalSupply string
+	}{
+		{
+			"default Maltcoin settings",
+			util.DefaultTokenSettings(),
+			"10000000000000000000000",
+		},
+		{
+			"token for another environment",
+			util.TokenSettings{Name: "Maltcoin Testnet", Symbol: "tMALT", InitialSupply: new(big.Int).Mul(big.NewInt(250), util.Ten18)},
+			"250000000000000000000",
+		},
+		{
+			"initial supply assigned to another holder",
+			util.TokenSettings{Name: "Maltcoin", Symbol: "MALT", InitialSupply: big.NewInt(1), InitialHolder: holder},
+			"1",
+		},
+	}
 
-	// Get simulated backend and transaction signer for testing
-	client, auth, err := util.GetSimulatedClientAndTransactionSigner(privKey, blockGasLimit, chainID)
-	require.NoError(t, err, "Error getting client and transaction signer")
-
-	// Deploy contract
-	_, _, contract, err := util.DeployContractAndCommit(auth, client)
-	require.NoError(t, err, "Could not deploy contract")
-
-	// Get name of token
-	name, err := contract.Name(nil)
-	require.NoError(t, err, "Could not retrieve token name")
-	require.Equal(t, "Maltcoin", name, "Token name should be Maltcoin")
-
-	// Get token symbol
-	symbol, err := contract.Symbol(nil)
-	require.NoError(t, err, "Could not retrieve token symbol")
-	require.Equal(t, "MALT", symbol, "Token symbol should be MALT")
-
-	// Get token decimals
-	decimals, err := contract.Decimals(nil)
-	require.NoError(t, err, "Could not retrieve token decimals")
-	require.Equal(t, uint8(18), decimals, "Token decimals should be 18")
-
-	// Get total supply
-	totalSupply, err := contract.TotalSupply(nil)
-	require.NoError(t, err, "Could not retrieve total supply")
-	require.Equal(t, "10000000000000000000000", totalSupply.String(), "Wrong total supply")
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Get simulated backend and transaction signer for testing
+			client, auth, err := util.GetSimulatedClientAndTransactionSigner(privKey, blockGasLimit, chainID)
+			require.NoError(t, err, "Error getting client and transaction signer")
+
+			// Deploy contract
+			_, _, contract, err := util.DeployContractAndCommit(auth, client, tc.settings)
+			require.NoError(t, err, "Could not deploy contract")
+
+			// Get name of token
+			name, err := contract.Name(nil)
+			require.NoError(t, err, "Could not retrieve token name")
+			require.Equal(t, tc.settings.Name, name, "Wrong token name")
+
+			// Get token symbol
+			symbol, err := contract.Symbol(nil)
+			require.NoError(t, err, "Could not retrieve token symbol")
+			require.Equal(t, tc.settings.Symbol, symbol, "Wrong token symbol")
+
+			// Get token decimals
+			decimals, err := contract.Decimals(nil)
+			require.NoError(t, err, "Could not retrieve token decimals")
+			require.Equal(t, uint8(18), decimals, "Token decimals should be 18")
+
+			// Get total supply
+			totalSupply, err := contract.TotalSupply(nil)
+			require.NoError(t, err, "Could not retrieve total supply")
+			require.Equal(t, tc.expTotalSupply, totalSupply.String(), "Wrong total supply")
+
+			// Get balance of the initial holder
+			balance, err := contract.BalanceOf(nil, tc.settings.Holder(auth.From))
+			require.NoError(t, err, "Could not retrieve balance of initial holder")
+			require.Equal(t, tc.expTotalSupply, balance.String(), "Initial holder should hold the total supply")
+		})
+	}
 }
@@ -0,0 +1,215 @@
+// managed_bdd_test.go contains the testing suite for the managed variant of
+// the Maltcoin smart contract, which checks that minting, pausing and the
+// administration of roles are restricted to the accounts with the roles.
+//
+// The specs are run by TestMaltcoin together with the other specs of the
+// package.
+package maltcoin_tests
+
+import (
+	"math/big"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	maltcoin "github.com/MalteHerrmann/GoSmartContract/contracts/build"
+	"github.com/MalteHerrmann/GoSmartContract/scripts/util"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// ManagedTestSuite contains the deployed managed token contract and the
+// transaction signers of the admin, which has all roles, and of another
+// account, which has no roles initially.
+type ManagedTestSuite struct {
+	addresses []common.Address
+	admin     *bind.TransactOpts
+	other     *bind.TransactOpts
+	client    *util.SimulatedClient
+	contract  *maltcoin.ManagedMaltcoin
+}
+
+// Initialize the managed test suite
+var m = new(ManagedTestSuite)
+
+// SetupTest deploys an instance of the managed token contract to a simulated
+// backend, on which the admin and the other account hold funds to pay fees.
+func (suite *ManagedTestSuite) SetupTest() {
+	privKeys, addresses, err := util.DerivePrivKeysAndAddresses(util.TestMnemonic, "", 3)
+	Expect(err).To(BeNil())
+
+	funds := new(big.Int).Mul(big.NewInt(1000), util.Ten18)
+	client := &util.SimulatedClient{SimulatedBackend: backends.NewSimulatedBackend(core.GenesisAlloc{
+		addresses[0]: {Balance: funds},
+		addresses[1]: {Balance: funds},
+	}, util.MaxGasPerBlock)}
+	admin, err := util.NewTransactionSigner(client, privKeys[0])
+	Expect(err).To(BeNil())
+	other, err := util.NewTransactionSigner(client, privKeys[1])
+	Expect(err).To(BeNil())
+
+	// The deployment fills the transaction signer fields, so that a copy is
+	// used to let the bindings fill them for the later transactions.
+	deployer := *admin
+	_, _, contract, err := util.DeployManagedContract(&deployer, client, util.DefaultTokenSettings(), common.Address{}, util.DefaultFeeOptions())
+	Expect(err).To(BeNil())
+
+	suite.addresses = addresses
+	suite.admin = admin
+	suite.other = other
+	suite.client = client
+	suite.contract = contract
+}
+
+// send commits the transaction of a contract method, if it could be sent,
+// and returns the error otherwise.
+func (suite *ManagedTestSuite) send(_ interface{}, err error) error {
+	if err == nil {
+		suite.client.Commit()
+	}
+
+	return err
+}
+
+// hasRole reports, if the account has the role.
+func (suite *ManagedTestSuite) hasRole(role util.Role, account common.Address) bool {
+	has, err := suite.contract.HasRole(nil, role.Hash, account)
+	Expect(err).To(BeNil())
+
+	return has
+}
+
+var _ = Describe("mint:", func() {
+	BeforeEach(func() {
+		m.SetupTest()
+	})
+
+	amount := util.Ten18
+
+	Context("When the sender has the minter role", func() {
+		It("should have increased the balance of the recipient and the total supply", func() {
+			Expect(m.send(m.contract.Mint(m.admin, m.addresses[2], amount))).To(Succeed())
+
+			balance, err := m.contract.BalanceOf(nil, m.addresses[2])
+			Expect(balance.Cmp(amount), err).To(Equal(0))
+			totalSupply, err := m.contract.TotalSupply(nil)
+			Expect(totalSupply.Cmp(new(big.Int).Add(util.DefaultTokenSettings().InitialSupply, amount)), err).To(Equal(0))
+		})
+	})
+
+	Context("When the sender does not have the minter role", func() {
+		It("should be rejected", func() {
+			Expect(m.send(m.contract.Mint(m.other, m.addresses[2], amount))).To(MatchError(ContainSubstring("is missing role")))
+
+			balance, err := m.contract.BalanceOf(nil, m.addresses[2])
+			Expect(balance.Sign(), err).To(Equal(0))
+		})
+	})
+})
+
+var _ = Describe("burn:", func() {
+	BeforeEach(func() {
+		m.SetupTest()
+	})
+
+	Context("When a holder burns its tokens", func() {
+		It("should have decreased its balance and the total supply without any role", func() {
+			amount := util.Ten18
+			Expect(m.send(m.contract.Transfer(m.admin, m.addresses[1], amount))).To(Succeed())
+			Expect(m.send(m.contract.Burn(m.other, amount))).To(Succeed())
+
+			balance, err := m.contract.BalanceOf(nil, m.addresses[1])
+			Expect(balance.Sign(), err).To(Equal(0))
+			totalSupply, err := m.contract.TotalSupply(nil)
+			Expect(totalSupply.Cmp(new(big.Int).Sub(util.DefaultTokenSettings().InitialSupply, amount)), err).To(Equal(0))
+		})
+	})
+
+	Context("When tokens of another holder are burned without allowance", func() {
+		It("should be rejected", func() {
+			Expect(m.send(m.contract.BurnFrom(m.other, m.addresses[0], util.Ten18))).To(MatchError(ContainSubstring("insufficient allowance")))
+		})
+	})
+})
+
+var _ = Describe("pause:", func() {
+	BeforeEach(func() {
+		m.SetupTest()
+	})
+
+	Context("When the sender does not have the pauser role", func() {
+		It("should be rejected", func() {
+			Expect(m.send(m.contract.Pause(m.other))).To(MatchError(ContainSubstring("is missing role")))
+
+			paused, err := m.contract.Paused(nil)
+			Expect(paused, err).To(BeFalse())
+		})
+	})
+
+	Context("When the pauser paused the token", func() {
+		BeforeEach(func() {
+			Expect(m.send(m.contract.Pause(m.admin))).To(Succeed())
+		})
+
+		It("should reject transfers, mints and burns", func() {
+			Expect(m.send(m.contract.Transfer(m.admin, m.addresses[1], util.Ten18))).To(MatchError(ContainSubstring("token transfer while paused")))
+			Expect(m.send(m.contract.Mint(m.admin, m.addresses[1], util.Ten18))).To(MatchError(ContainSubstring("token transfer while paused")))
+			Expect(m.send(m.contract.Burn(m.admin, util.Ten18))).To(MatchError(ContainSubstring("token transfer while paused")))
+		})
+
+		It("should not be unpaused by others", func() {
+			Expect(m.send(m.contract.Unpause(m.other))).To(MatchError(ContainSubstring("is missing role")))
+		})
+
+		It("should allow transfers again after unpausing", func() {
+			Expect(m.send(m.contract.Unpause(m.admin))).To(Succeed())
+			Expect(m.send(m.contract.Transfer(m.admin, m.addresses[1], util.Ten18))).To(Succeed())
+		})
+	})
+})
+
+var _ = Describe("roles:", func() {
+	BeforeEach(func() {
+		m.SetupTest()
+	})
+
+	Context("After deployment", func() {
+		It("should have granted all roles to the deployer only", func() {
+			for _, role := range util.ManagedRoles() {
+				Expect(m.hasRole(role, m.addresses[0])).To(BeTrue(), role.Name)
+				Expect(m.hasRole(role, m.addresses[1])).To(BeFalse(), role.Name)
+			}
+		})
+	})
+
+	Context("When an account without the admin role grants a role", func() {
+		It("should be rejected", func() {
+			err := m.send(m.contract.GrantRole(m.other, util.MinterRole.Hash, m.addresses[1]))
+			Expect(err).To(MatchError(ContainSubstring("is missing role " + util.AdminRole.Hash.Hex())))
+			Expect(m.hasRole(util.MinterRole, m.addresses[1])).To(BeFalse())
+		})
+	})
+
+	Context("When the admin grants the minter role", func() {
+		BeforeEach(func() {
+			Expect(m.send(m.contract.GrantRole(m.admin, util.MinterRole.Hash, m.addresses[1]))).To(Succeed())
+		})
+
+		It("should allow the account to mint, but not to pause", func() {
+			Expect(m.send(m.contract.Mint(m.other, m.addresses[1], util.Ten18))).To(Succeed())
+			Expect(m.send(m.contract.Pause(m.other))).To(MatchError(ContainSubstring("is missing role")))
+		})
+
+		It("should not allow the minter to grant roles", func() {
+			Expect(m.send(m.contract.GrantRole(m.other, util.MinterRole.Hash, m.addresses[2]))).To(MatchError(ContainSubstring("is missing role")))
+		})
+
+		It("should reject mints after the admin revoked the role", func() {
+			Expect(m.send(m.contract.RevokeRole(m.admin, util.MinterRole.Hash, m.addresses[1]))).To(Succeed())
+			Expect(m.hasRole(util.MinterRole, m.addresses[1])).To(BeFalse())
+			Expect(m.send(m.contract.Mint(m.other, m.addresses[1], util.Ten18))).To(MatchError(ContainSubstring("is missing role")))
+		})
+	})
+})